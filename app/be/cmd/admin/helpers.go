@@ -0,0 +1,71 @@
+// cmd/admin/helpers.go
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// parseArgs parses flags that may appear before or after positional arguments
+func parseArgs(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
+
+// extractDryRun pulls --dry-run out of the arguments so every subcommand accepts it after its own flags
+func extractDryRun(args []string) ([]string, bool, error) {
+	var rest []string
+	dryRun := false
+	for _, arg := range args {
+		name, value, hasValue := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		if !strings.HasPrefix(arg, "-") || name != "dry-run" {
+			rest = append(rest, arg)
+			continue
+		}
+		if !hasValue {
+			dryRun = true
+			continue
+		}
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid value %q for --dry-run", value)
+		}
+		dryRun = enabled
+	}
+	return rest, dryRun, nil
+}
+
+// requireNote rejects commands that change state without an explanation
+func requireNote(note string) error {
+	if strings.TrimSpace(note) == "" {
+		return errors.New("a note is required (--note)")
+	}
+	return nil
+}
+
+// printJSON writes a value as indented JSON
+func printJSON(w io.Writer, value interface{}) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(value)
+}
+
+// printDryRun reports a change that was not applied
+func printDryRun(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stdout, "[dry-run] "+format+"\n", args...)
+}
@@ -0,0 +1,88 @@
+// cmd/admin/hotspot.go
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+)
+
+// runHotspotTransfer hands control of a hotspot to a player
+func runHotspotTransfer(rt *runtime, args []string) error {
+	fs := flag.NewFlagSet("hotspot transfer", flag.ContinueOnError)
+	note := fs.String("note", "", "Reason for the transfer, recorded for audit")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 2 {
+		return errors.New("usage: " + commands["hotspot transfer"].usage)
+	}
+	if err := requireNote(*note); err != nil {
+		return err
+	}
+
+	hotspotID, playerID := positional[0], positional[1]
+
+	if rt.dryRun {
+		hotspot, err := rt.territoryRepo.GetHotspotByID(hotspotID)
+		if err != nil {
+			return err
+		}
+		player, err := rt.playerRepo.GetPlayerByID(playerID)
+		if err != nil {
+			return err
+		}
+		printDryRun("would transfer %s from %s to %s: %s", hotspot.Name, controllerLabel(hotspot.ControllerName), player.Name, *note)
+		return nil
+	}
+
+	hotspot, err := rt.adminService.TransferHotspot(rt.actorID(), hotspotID, playerID, *note)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Transferred %s to %s\n", hotspot.Name, controllerLabel(hotspot.ControllerName))
+	return nil
+}
+
+// runHotspotClear releases a hotspot back to neutral
+func runHotspotClear(rt *runtime, args []string) error {
+	fs := flag.NewFlagSet("hotspot clear", flag.ContinueOnError)
+	note := fs.String("note", "", "Reason for the release, recorded for audit")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return errors.New("usage: " + commands["hotspot clear"].usage)
+	}
+	if err := requireNote(*note); err != nil {
+		return err
+	}
+
+	if rt.dryRun {
+		hotspot, err := rt.territoryRepo.GetHotspotByID(positional[0])
+		if err != nil {
+			return err
+		}
+		printDryRun("would release %s from %s: %s", hotspot.Name, controllerLabel(hotspot.ControllerName), *note)
+		return nil
+	}
+
+	hotspot, err := rt.adminService.ReleaseHotspot(rt.actorID(), positional[0], *note)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Released %s\n", hotspot.Name)
+	return nil
+}
+
+// controllerLabel formats an optional controller name
+func controllerLabel(name *string) string {
+	if name == nil || *name == "" {
+		return "nobody"
+	}
+	return *name
+}
@@ -0,0 +1,71 @@
+// cmd/admin/jobs.go
+package main
+
+import (
+	"fmt"
+)
+
+// runOperationsRefresh forces the daily operations refresh
+func runOperationsRefresh(rt *runtime, args []string) error {
+	if rt.dryRun {
+		operations, err := rt.operationsRepo.GetAllOperations()
+		if err != nil {
+			return err
+		}
+		printDryRun("would refresh the operations pool (%d operations currently available)", len(operations))
+		return nil
+	}
+
+	if err := rt.operationsService.RefreshDailyOperations(); err != nil {
+		return err
+	}
+
+	fmt.Println("Operations refreshed")
+	return nil
+}
+
+// runMarketUpdate forces a market price update
+func runMarketUpdate(rt *runtime, args []string) error {
+	if rt.dryRun {
+		listings, err := rt.marketRepo.GetAllListings()
+		if err != nil {
+			return err
+		}
+		printDryRun("would update prices for %d market listings", len(listings))
+		return nil
+	}
+
+	if err := rt.marketService.UpdateMarketPrices(); err != nil {
+		return err
+	}
+
+	fmt.Println("Market prices updated")
+	return nil
+}
+
+// runIllegalRotate forces a rotation of the illegal businesses
+func runIllegalRotate(rt *runtime, args []string) error {
+	if rt.dryRun {
+		hotspots, err := rt.territoryRepo.GetAllHotspots()
+		if err != nil {
+			return err
+		}
+
+		illegal := 0
+		for _, hotspot := range hotspots {
+			if !hotspot.IsLegal {
+				illegal++
+			}
+		}
+		printDryRun("would retire %d illegal businesses and spawn replacements from the pool", illegal)
+		return nil
+	}
+
+	result, err := rt.territoryService.RotateIllegalBusinesses()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Illegal businesses rotated: %d retired, %d spawned across %d cities\n", result.Retired, result.Spawned, result.Cities)
+	return nil
+}
@@ -0,0 +1,250 @@
+// cmd/admin/main.go
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"os/user"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"mwce-be/internal/app"
+	"mwce-be/internal/config"
+	"mwce-be/internal/model"
+	"mwce-be/internal/repository"
+	"mwce-be/internal/service"
+	"mwce-be/pkg/database"
+)
+
+// command is a single operator CLI subcommand
+type command struct {
+	usage       string
+	description string
+	run         func(rt *runtime, args []string) error
+}
+
+// commands lists every subcommand keyed by "<group> <action>"
+var commands map[string]command
+
+func init() {
+	commands = map[string]command{
+		"player inspect": {
+			usage:       "player inspect <id|name|email>",
+			description: "Show a player's resources, hotspots, operations, campaign progress and sanctions",
+			run:         runPlayerInspect,
+		},
+		"player grant": {
+			usage:       "player grant <player-id> --note <text> [--money N] [--crew N] [--weapons N] [--vehicles N] [--respect N] [--influence N] [--heat N]",
+			description: "Add (or with negative amounts, remove) resources from a player",
+			run:         runPlayerGrant,
+		},
+		"hotspot transfer": {
+			usage:       "hotspot transfer <hotspot-id> <player-id> --note <text>",
+			description: "Hand control of a hotspot to a player",
+			run:         runHotspotTransfer,
+		},
+		"hotspot clear": {
+			usage:       "hotspot clear <hotspot-id> --note <text>",
+			description: "Release a hotspot back to neutral",
+			run:         runHotspotClear,
+		},
+		"operations refresh": {
+			usage:       "operations refresh",
+			description: "Force a refresh of the daily operations pool",
+			run:         runOperationsRefresh,
+		},
+		"market update": {
+			usage:       "market update",
+			description: "Force a market price update",
+			run:         runMarketUpdate,
+		},
+		"illegal rotate": {
+			usage:       "illegal rotate",
+			description: "Rotate the illegal businesses",
+			run:         runIllegalRotate,
+		},
+		"world dump": {
+			usage:       "world dump [--out <file>]",
+			description: "Dump the world state to JSON",
+			run:         runWorldDump,
+		},
+	}
+}
+
+// runtime holds the shared dependencies for every subcommand
+type runtime struct {
+	cfg      *config.Config
+	logger   zerolog.Logger
+	operator string
+	dryRun   bool
+
+	playerRepo     repository.PlayerRepository
+	territoryRepo  repository.TerritoryRepository
+	operationsRepo repository.OperationsRepository
+	marketRepo     repository.MarketRepository
+
+	adminService      service.AdminService
+	territoryService  service.TerritoryService
+	operationsService service.OperationsService
+	marketService     service.MarketService
+}
+
+// actorID identifies the operator in logs of who made a change
+func (rt *runtime) actorID() string {
+	return "cli:" + rt.operator
+}
+
+func main() {
+	// Parse global flags
+	configPath := flag.String("config", "../../configs/app.yaml", "Path to application configuration file")
+	operator := flag.String("operator", defaultOperator(), "Name of the person running the command, recorded in the audit log")
+	dryRun := flag.Bool("dry-run", false, "Show what would change without writing anything")
+	flag.Usage = printUsage
+	flag.Parse()
+
+	// --dry-run is also accepted anywhere after the command
+	args, subDryRun, err := extractDryRun(flag.Args())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n\n", err)
+		printUsage()
+		os.Exit(2)
+	}
+	if subDryRun {
+		*dryRun = true
+	}
+
+	if len(args) < 2 {
+		printUsage()
+		os.Exit(2)
+	}
+
+	name := args[0] + " " + args[1]
+	cmd, exists := commands[name]
+	if !exists {
+		fmt.Fprintf(os.Stderr, "unknown command: %s\n\n", name)
+		printUsage()
+		os.Exit(2)
+	}
+
+	if strings.TrimSpace(*operator) == "" {
+		fmt.Fprintln(os.Stderr, "an operator name is required (--operator)")
+		os.Exit(2)
+	}
+
+	// Log to stderr so command output on stdout stays machine-readable
+	l := zerolog.New(zerolog.ConsoleWriter{Out: os.Stderr, TimeFormat: time.RFC3339}).With().Timestamp().Logger()
+
+	rt, err := newRuntime(*configPath, *dryRun, l)
+	if err != nil {
+		l.Fatal().Err(err).Msg("Failed to initialize")
+	}
+	rt.operator = *operator
+
+	// Run the command and record it
+	runErr := cmd.run(rt, args[2:])
+	rt.recordCommand(name, args[2:], runErr)
+
+	if runErr != nil {
+		l.Error().Err(runErr).Str("command", name).Msg("Command failed")
+		os.Exit(1)
+	}
+}
+
+// newRuntime loads the configuration and wires the repositories and services
+func newRuntime(configPath string, dryRun bool, l zerolog.Logger) (*runtime, error) {
+	cfg, err := config.LoadAllConfigs(configPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	db, err := database.NewPostgresDB(cfg.Database)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	// Make sure every table the commands write exists, even if the server has not been upgraded yet.
+	// A dry run leaves the schema alone like everything else.
+	if !dryRun {
+		if err := app.Migrate(db.GetDB()); err != nil {
+			return nil, fmt.Errorf("failed to migrate database: %w", err)
+		}
+	}
+
+	playerRepo := repository.NewPlayerRepository(db)
+	territoryRepo := repository.NewTerritoryRepository(db)
+	operationsRepo := repository.NewOperationsRepository(db)
+	marketRepo := repository.NewMarketRepository(db)
+	campaignRepo := repository.NewCampaignRepository(db)
+	adminRepo := repository.NewAdminRepository(db)
+
+	// Nobody is connected to the CLI, so live events simply go nowhere
+	sseService := service.NewSSEService(l)
+	neighborhoodService := service.NewNeighborhoodService(territoryRepo, playerRepo, *cfg.Game, l)
+	playerService := service.NewPlayerService(playerRepo, neighborhoodService, *cfg.Game, l)
+	territoryService := service.NewTerritoryService(territoryRepo, playerRepo, neighborhoodService, sseService, *cfg.Game, l,
+		[]service.CustomHotspotProvider{})
+
+	return &runtime{
+		cfg:            cfg,
+		logger:         l,
+		dryRun:         dryRun,
+		playerRepo:     playerRepo,
+		territoryRepo:  territoryRepo,
+		operationsRepo: operationsRepo,
+		marketRepo:     marketRepo,
+		adminService: service.NewAdminService(adminRepo, playerRepo, territoryRepo, operationsRepo, campaignRepo, territoryService,
+			sseService, *cfg.Game, l),
+		territoryService: territoryService,
+		operationsService: service.NewOperationsService(operationsRepo, territoryRepo, playerRepo, playerService, neighborhoodService, sseService,
+			*cfg.Game, l, []service.CustomOperationsProvider{}),
+		marketService: service.NewMarketService(marketRepo, playerRepo, playerService, cfg.Game, l),
+	}, nil
+}
+
+// recordCommand writes who ran what to the operator audit table
+func (rt *runtime) recordCommand(name string, args []string, runErr error) {
+	entry := &model.OperatorCommandLog{
+		Operator:  rt.operator,
+		Command:   name,
+		Args:      strings.Join(args, " "),
+		DryRun:    rt.dryRun,
+		Succeeded: runErr == nil,
+		Timestamp: time.Now(),
+	}
+	if runErr != nil {
+		entry.Error = runErr.Error()
+	}
+
+	rt.adminService.RecordOperatorCommand(entry)
+}
+
+// defaultOperator falls back to the OS user running the CLI
+func defaultOperator() string {
+	if current, err := user.Current(); err == nil {
+		return current.Username
+	}
+	return os.Getenv("USER")
+}
+
+// printUsage lists the global flags and every subcommand
+func printUsage() {
+	fmt.Fprintf(os.Stderr, "Usage: admin [--config <file>] [--operator <name>] [--dry-run] <command> [flags] [--dry-run]\n\n")
+	fmt.Fprintf(os.Stderr, "Commands:\n")
+
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %s\n      %s\n", commands[name].usage, commands[name].description)
+	}
+
+	fmt.Fprintf(os.Stderr, "\nGlobal flags:\n")
+	flag.PrintDefaults()
+}
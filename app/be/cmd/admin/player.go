@@ -0,0 +1,101 @@
+// cmd/admin/player.go
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"mwce-be/internal/util"
+)
+
+// runPlayerInspect prints the full state of a player
+func runPlayerInspect(rt *runtime, args []string) error {
+	fs := flag.NewFlagSet("player inspect", flag.ContinueOnError)
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return errors.New("usage: " + commands["player inspect"].usage)
+	}
+
+	// Resolve the query to a single player
+	players, err := rt.adminService.SearchPlayers(positional[0], 10)
+	if err != nil {
+		return err
+	}
+	if len(players) == 0 {
+		return fmt.Errorf("no player matches %q", positional[0])
+	}
+	if len(players) > 1 {
+		fmt.Fprintf(os.Stderr, "%d players match %q, narrow the query:\n", len(players), positional[0])
+		for _, player := range players {
+			fmt.Fprintf(os.Stderr, "  %s  %s  <%s>\n", player.ID, player.Name, player.Email)
+		}
+		return errors.New("ambiguous player query")
+	}
+
+	state, err := rt.adminService.GetPlayerState(players[0].ID)
+	if err != nil {
+		return err
+	}
+
+	return printJSON(os.Stdout, state)
+}
+
+// runPlayerGrant applies a resource adjustment to a player
+func runPlayerGrant(rt *runtime, args []string) error {
+	fs := flag.NewFlagSet("player grant", flag.ContinueOnError)
+	note := fs.String("note", "", "Reason for the grant, recorded for audit")
+	amounts := map[string]*int{
+		util.ResourceTypeMoney:     fs.Int("money", 0, "Money to add"),
+		util.ResourceTypeCrew:      fs.Int("crew", 0, "Crew to add"),
+		util.ResourceTypeWeapons:   fs.Int("weapons", 0, "Weapons to add"),
+		util.ResourceTypeVehicles:  fs.Int("vehicles", 0, "Vehicles to add"),
+		util.ResourceTypeRespect:   fs.Int("respect", 0, "Respect to add"),
+		util.ResourceTypeInfluence: fs.Int("influence", 0, "Influence to add"),
+		util.ResourceTypeHeat:      fs.Int("heat", 0, "Heat to add"),
+	}
+
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return errors.New("usage: " + commands["player grant"].usage)
+	}
+	if err := requireNote(*note); err != nil {
+		return err
+	}
+
+	resources := make(map[string]int)
+	for resourceType, amount := range amounts {
+		if *amount != 0 {
+			resources[resourceType] = *amount
+		}
+	}
+	if len(resources) == 0 {
+		return errors.New("no resources to grant")
+	}
+
+	playerID := positional[0]
+
+	if rt.dryRun {
+		player, err := rt.playerRepo.GetPlayerByID(playerID)
+		if err != nil {
+			return err
+		}
+		printDryRun("would adjust %s (%s) by %v: %s", player.Name, player.ID, resources, *note)
+		return nil
+	}
+
+	player, err := rt.adminService.AdjustPlayerResourcesAsOperator(rt.operator, playerID, resources, *note)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Adjusted %s (%s) by %v\n", player.Name, player.ID, resources)
+	return nil
+}
@@ -0,0 +1,73 @@
+// cmd/admin/world.go
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"mwce-be/internal/model"
+)
+
+// WorldState is a snapshot of the shared game world
+type WorldState struct {
+	GeneratedAt    time.Time             `json:"generatedAt"`
+	Regions        []model.Region        `json:"regions"`
+	Districts      []model.District      `json:"districts"`
+	Cities         []model.City          `json:"cities"`
+	Hotspots       []model.Hotspot       `json:"hotspots"`
+	Operations     []model.Operation     `json:"operations"`
+	MarketListings []model.MarketListing `json:"marketListings"`
+}
+
+// runWorldDump writes the world state as JSON to stdout or a file
+func runWorldDump(rt *runtime, args []string) error {
+	fs := flag.NewFlagSet("world dump", flag.ContinueOnError)
+	out := fs.String("out", "", "File to write to instead of stdout")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 0 {
+		return errors.New("usage: " + commands["world dump"].usage)
+	}
+
+	state := WorldState{GeneratedAt: time.Now()}
+	if state.Regions, err = rt.territoryRepo.GetAllRegions(); err != nil {
+		return err
+	}
+	if state.Districts, err = rt.territoryRepo.GetAllDistricts(); err != nil {
+		return err
+	}
+	if state.Cities, err = rt.territoryRepo.GetAllCities(); err != nil {
+		return err
+	}
+	if state.Hotspots, err = rt.territoryRepo.GetAllHotspots(); err != nil {
+		return err
+	}
+	if state.Operations, err = rt.operationsRepo.GetAllOperations(); err != nil {
+		return err
+	}
+	if state.MarketListings, err = rt.marketRepo.GetAllListings(); err != nil {
+		return err
+	}
+
+	if *out == "" {
+		return printJSON(os.Stdout, state)
+	}
+
+	file, err := os.Create(*out)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", *out, err)
+	}
+	defer file.Close()
+
+	if err := printJSON(file, state); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "World state written to %s\n", *out)
+	return nil
+}
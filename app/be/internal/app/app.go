@@ -4,13 +4,14 @@ package app
 
 import (
 	"fmt"
+	"path/filepath"
 
 	"mwce-be/internal/config"
 	"mwce-be/internal/controller"
 	appMiddleware "mwce-be/internal/middleware"
-	"mwce-be/internal/model"
 	"mwce-be/internal/repository"
 	"mwce-be/internal/service"
+	"mwce-be/internal/util"
 	"mwce-be/pkg/database"
 
 	"github.com/go-chi/chi/v5"
@@ -35,38 +36,7 @@ func NewApp(cfg *config.Config, logger zerolog.Logger) (*App, error) {
 	}
 
 	// Migrate the models
-	err = db.GetDB().AutoMigrate(
-		&model.Player{},
-		&model.PlayerStats{},
-		&model.Notification{},
-		&model.Achievement{},
-		&model.PlayerAchievement{},
-		&model.Region{},
-		&model.District{},
-		&model.City{},
-		&model.Hotspot{},
-		&model.TerritoryAction{},
-		&model.Operation{},
-		&model.OperationAttempt{},
-		&model.MarketListing{},
-		&model.MarketTransaction{},
-		&model.MarketPriceHistory{},
-		&model.TravelAttempt{},
-
-		// Campaign-related models
-		&model.Campaign{},
-		&model.Chapter{},
-		&model.Mission{},
-		&model.Branch{},
-		&model.CampaignOperation{},
-		&model.CampaignPOI{},
-		&model.Dialogue{},
-		&model.PlayerCampaignProgress{},
-		&model.PlayerOperationRecord{},
-		&model.PlayerPOIRecord{},
-		&model.DialogueState{},
-	)
-	if err != nil {
+	if err := Migrate(db.GetDB()); err != nil {
 		return nil, fmt.Errorf("failed to migrate database: %w", err)
 	}
 
@@ -96,15 +66,26 @@ func NewApp(cfg *config.Config, logger zerolog.Logger) (*App, error) {
 	operationsRepo := repository.NewOperationsRepository(db)
 	marketRepo := repository.NewMarketRepository(db)
 	campaignRepo := repository.NewCampaignRepository(db)
+	adminRepo := repository.NewAdminRepository(db)
+	contentRepo := repository.NewContentRepository(db)
+	announcementRepo := repository.NewAnnouncementRepository(db)
 
 	// Initialize services
-	playerService := service.NewPlayerService(playerRepo, *cfg.Game, logger)
+	neighborhoodService := service.NewNeighborhoodService(territoryRepo, playerRepo, *cfg.Game, logger)
+	playerService := service.NewPlayerService(playerRepo, neighborhoodService, *cfg.Game, logger)
 	authService := service.NewAuthService(playerRepo, playerService, cfg.JWT, logger)
 	sseService := service.NewSSEService(logger)
 
 	// Initialize territory and operations services with empty slices for providers
-	territoryService := service.NewTerritoryService(territoryRepo, playerRepo, sseService, *cfg.Game, logger, []service.CustomHotspotProvider{})
-	operationsService := service.NewOperationsService(operationsRepo, territoryRepo, playerRepo, playerService, sseService, *cfg.Game, logger, []service.CustomOperationsProvider{})
+	territoryService := service.NewTerritoryService(territoryRepo, playerRepo, neighborhoodService, sseService, *cfg.Game, logger, []service.CustomHotspotProvider{})
+	adminService := service.NewAdminService(adminRepo, playerRepo, territoryRepo, operationsRepo, campaignRepo, territoryService, sseService, *cfg.Game, logger)
+
+	contentService := service.NewContentService(db, contentRepo, territoryRepo, operationsRepo, campaignRepo, logger)
+
+	// Promote configured accounts to admin
+	adminService.BootstrapAdmins(cfg.Admin.BootstrapEmails)
+
+	operationsService := service.NewOperationsService(operationsRepo, territoryRepo, playerRepo, playerService, neighborhoodService, sseService, *cfg.Game, logger, []service.CustomOperationsProvider{})
 	campaignService := service.NewCampaignService(campaignRepo, playerRepo, territoryRepo, playerService, sseService, logger)
 
 	// Add the campaign service as a provider to territory and operations services
@@ -118,7 +99,7 @@ func NewApp(cfg *config.Config, logger zerolog.Logger) (*App, error) {
 		return nil, err
 	}
 	if regions == nil || len(regions) <= 0 {
-		service.RunTerritorySeeder("../../configs/app.yaml", "../../configs/territory.yaml")
+		service.RunTerritorySeeder(filepath.Join(cfg.Game.ConfigDir, "app.yaml"), filepath.Join(cfg.Game.ConfigDir, "territory.yaml"))
 	}
 
 	// -- If no campaigns, seed campaign data --
@@ -132,11 +113,30 @@ func NewApp(cfg *config.Config, logger zerolog.Logger) (*App, error) {
 
 	marketService := service.NewMarketService(marketRepo, playerRepo, playerService, cfg.Game, logger)
 	travelService := service.NewTravelService(playerRepo, territoryRepo, sseService, *cfg.Game, logger)
+	heatService := service.NewHeatService(playerRepo, territoryService, sseService, *cfg.Game, logger)
+	jailService := service.NewJailService(playerRepo, sseService, *cfg.Game, logger)
+	policeService := service.NewPoliceService(playerRepo, territoryRepo, operationsRepo, territoryService, sseService, *cfg.Game, logger)
+	announcementService := service.NewAnnouncementService(announcementRepo, playerRepo, territoryRepo, sseService, *cfg.Game, logger)
+
+	// -- If no roads between regions, seed them from the territory data --
+	if err := travelService.SeedRegionRoutes(); err != nil {
+		logger.Warn().Err(err).Msg("Failed to seed region routes")
+	}
 
 	// Start scheduled jobs
 	operationsService.StartPeriodicOperationsRefresh()
 	marketService.StartPeriodicMarketPriceUpdates()
-	territoryService.StartPeriodicIncomeGeneration()
+	territoryService.StartPeriodicIncomeNotifications()
+	travelService.StartPeriodicArrivals()
+	territoryService.StartPeriodicIllegalBusinessRotation()
+	territoryService.StartPeriodicGarrisonTransfers()
+	territoryService.StartPeriodicFortificationUpgrades()
+	territoryService.StartPeriodicAssaultResolution()
+	territoryService.StartPeriodicLocalHeatDecay()
+	announcementService.StartPeriodicAnnouncementDelivery()
+	heatService.StartPeriodicHeatDecay()
+	policeService.StartPeriodicRaids()
+	neighborhoodService.StartPeriodicInfluenceGrants()
 
 	// Initialize controllers
 	authController := controller.NewAuthController(authService, logger)
@@ -147,9 +147,15 @@ func NewApp(cfg *config.Config, logger zerolog.Logger) (*App, error) {
 	marketController := controller.NewMarketController(marketService, logger)
 	travelController := controller.NewTravelController(travelService, logger)
 	campaignController := controller.NewCampaignController(campaignService, logger)
+	adminController := controller.NewAdminController(adminService, logger)
+	contentController := controller.NewContentController(contentService, logger)
+	heatController := controller.NewHeatController(heatService, logger)
+	jailController := controller.NewJailController(jailService, logger)
+	announcementController := controller.NewAnnouncementController(announcementService, logger)
 
 	// Auth middleware
 	authMiddleware := appMiddleware.NewAuthMiddleware(authService)
+	auditMiddleware := appMiddleware.NewAuditMiddleware(adminService)
 
 	// API routes
 	router.Route("/api", func(r chi.Router) {
@@ -175,6 +181,7 @@ func NewApp(cfg *config.Config, logger zerolog.Logger) (*App, error) {
 				r.Post("/notifications/read", playerController.MarkAllNotificationsRead)
 				r.Post("/notifications/{id}/read", playerController.MarkNotificationRead)
 				r.Post("/collect-all", playerController.CollectAllPending)
+				r.Get("/heat-history", heatController.GetHeatHistory)
 			})
 
 			// Travel routes
@@ -185,6 +192,13 @@ func NewApp(cfg *config.Config, logger zerolog.Logger) (*App, error) {
 				r.Get("/history", travelController.GetTravelHistory)
 			})
 
+			// Jail routes
+			r.Route("/jail", func(r chi.Router) {
+				r.Get("/", jailController.GetJailStatus)
+				r.Post("/bail", jailController.PayBail)
+				r.Post("/bribe", jailController.BribeJudge)
+			})
+
 			// Territory routes
 			r.Route("/territory", func(r chi.Router) {
 				r.Get("/regions", territoryController.GetRegions)
@@ -199,8 +213,14 @@ func NewApp(cfg *config.Config, logger zerolog.Logger) (*App, error) {
 				r.Get("/actions", territoryController.GetRecentActions)
 				r.Post("/actions/{action}", territoryController.PerformAction)
 				r.Post("/hotspots/{id}/collect", territoryController.CollectHotspotIncome)
+				r.Get("/hotspots/{id}/upgrades", territoryController.GetHotspotFortifications)
+				r.Get("/hotspots/{id}/history", territoryController.GetHotspotHistory)
+				r.Post("/hotspots/{id}/upgrades/{track}", territoryController.PurchaseUpgrade)
 				r.Post("/hotspots/collect-all", territoryController.CollectAllHotspotIncome)
 				r.Post("/hotspots/collect-all-regional", territoryController.CollectAllRegionalHotspotIncome)
+				r.Get("/garrisons/transfers", territoryController.GetGarrisonTransfers)
+				r.Post("/garrisons/rebalance", territoryController.RebalanceGarrisons)
+				r.Get("/assaults", territoryController.GetAssaults)
 			})
 
 			// Operations routes
@@ -257,6 +277,79 @@ func NewApp(cfg *config.Config, logger zerolog.Logger) (*App, error) {
 
 				r.Post("/operations/{id}/complete", campaignController.CompleteOperation)
 			})
+
+			// Announcement routes
+			r.Get("/announcements", announcementController.GetAnnouncements)
+
+			// Admin routes
+			r.Route("/admin", func(r chi.Router) {
+				// Audit first so refused attempts by non-staff accounts are recorded too
+				r.Use(auditMiddleware)
+				r.Use(authMiddleware.RequireRole(util.PlayerRoleModerator, util.PlayerRoleGameMaster, util.PlayerRoleAdmin))
+
+				r.Get("/audit", adminController.GetAuditLogs)
+
+				// Moderation routes
+				r.Get("/players", adminController.SearchPlayers)
+				r.Get("/players/{id}", adminController.GetPlayerState)
+				r.Post("/players/{id}/suspend", adminController.SuspendPlayer)
+				r.Post("/players/{id}/ban", adminController.BanPlayer)
+				r.Post("/players/{id}/lift", adminController.LiftSanction)
+				r.Post("/hotspots/{id}/release", adminController.ReleaseHotspot)
+
+				// Resource adjustment routes
+				r.Group(func(r chi.Router) {
+					r.Use(authMiddleware.RequireRole(util.PlayerRoleGameMaster, util.PlayerRoleAdmin))
+
+					r.Post("/players/{id}/adjust", adminController.AdjustPlayerResources)
+					r.Post("/players/{id}/reset", adminController.ResetPlayerResources)
+				})
+
+				// Announcement routes
+				r.Route("/announcements", func(r chi.Router) {
+					r.Use(authMiddleware.RequireRole(util.PlayerRoleGameMaster, util.PlayerRoleAdmin))
+
+					r.Get("/", announcementController.GetAllAnnouncements)
+					r.Post("/", announcementController.CreateAnnouncement)
+					r.Delete("/{id}", announcementController.CancelAnnouncement)
+				})
+
+				// Live-ops content routes
+				r.Route("/content", func(r chi.Router) {
+					r.Use(authMiddleware.RequireRole(util.PlayerRoleGameMaster, util.PlayerRoleAdmin))
+
+					r.Post("/regions", contentController.CreateRegion)
+					r.Put("/regions/{id}", contentController.UpdateRegion)
+					r.Delete("/regions/{id}", contentController.RetireRegion)
+					r.Post("/districts", contentController.CreateDistrict)
+					r.Put("/districts/{id}", contentController.UpdateDistrict)
+					r.Delete("/districts/{id}", contentController.RetireDistrict)
+					r.Post("/cities", contentController.CreateCity)
+					r.Put("/cities/{id}", contentController.UpdateCity)
+					r.Delete("/cities/{id}", contentController.RetireCity)
+					r.Post("/hotspots", contentController.CreateHotspot)
+					r.Put("/hotspots/{id}", contentController.UpdateHotspot)
+					r.Delete("/hotspots/{id}", contentController.RetireHotspot)
+
+					r.Get("/operations", contentController.GetOperationTemplates)
+					r.Post("/operations", contentController.CreateOperationTemplate)
+					r.Put("/operations/{id}", contentController.UpdateOperationTemplate)
+					r.Delete("/operations/{id}", contentController.RetireOperationTemplate)
+
+					r.Post("/campaigns", contentController.PublishCampaign)
+					r.Put("/campaigns/{id}", contentController.UpdateCampaign)
+					r.Post("/campaigns/{id}/chapters", contentController.PublishChapter)
+
+					r.Get("/versions", contentController.GetContentVersions)
+				})
+
+				// Admin-only routes
+				r.Group(func(r chi.Router) {
+					r.Use(authMiddleware.RequireRole(util.PlayerRoleAdmin))
+
+					r.Put("/players/{id}/role", adminController.UpdatePlayerRole)
+				})
+			})
 		})
 	})
 
@@ -0,0 +1,97 @@
+// internal/app/migrate.go
+
+package app
+
+import (
+	"time"
+
+	"mwce-be/internal/model"
+
+	"gorm.io/gorm"
+)
+
+// Migrate brings the database schema up to date with the models.
+// The server and the operator CLI both run it, so either can be upgraded first.
+func Migrate(db *gorm.DB) error {
+	if err := migrateOperatorAdjustments(db); err != nil {
+		return err
+	}
+
+	if err := db.AutoMigrate(
+		&model.Player{},
+		&model.PlayerStats{},
+		&model.Notification{},
+		&model.Achievement{},
+		&model.PlayerAchievement{},
+		&model.Region{},
+		&model.RegionRoute{},
+		&model.District{},
+		&model.City{},
+		&model.Hotspot{},
+		&model.TerritoryAction{},
+		&model.GarrisonTransfer{},
+		&model.HotspotUpgrade{},
+		&model.Assault{},
+		&model.HotspotOwnership{},
+		&model.Operation{},
+		&model.OperationAttempt{},
+		&model.MarketListing{},
+		&model.MarketTransaction{},
+		&model.MarketPriceHistory{},
+		&model.TravelAttempt{},
+		&model.Trip{},
+		&model.HeatHistory{},
+		&model.FailureStreak{},
+		&model.AdminAuditLog{},
+		&model.PlayerSanction{},
+		&model.ResourceAdjustment{},
+		&model.OperatorCommandLog{},
+		&model.ContentVersion{},
+		&model.OperationTemplate{},
+		&model.Announcement{},
+
+		// Campaign-related models
+		&model.Campaign{},
+		&model.Chapter{},
+		&model.Mission{},
+		&model.Branch{},
+		&model.CampaignOperation{},
+		&model.CampaignPOI{},
+		&model.Dialogue{},
+		&model.PlayerCampaignProgress{},
+		&model.PlayerOperationRecord{},
+		&model.PlayerPOIRecord{},
+		&model.DialogueState{},
+	); err != nil {
+		return err
+	}
+
+	return backfillIncomeTimes(db)
+}
+
+// backfillIncomeTimes starts the income clock of controlled businesses that never had one,
+// so they earn from now on instead of never
+func backfillIncomeTimes(db *gorm.DB) error {
+	return db.Model(&model.Hotspot{}).
+		Where("is_legal = ? AND controller_id IS NOT NULL AND last_income_time IS NULL", true).
+		Update("last_income_time", time.Now()).Error
+}
+
+// migrateOperatorAdjustments moves operator names out of resource_adjustments.actor_id.
+// The operator CLI used to store "cli:<operator>" there, which cannot become a uuid column.
+func migrateOperatorAdjustments(db *gorm.DB) error {
+	migrator := db.Migrator()
+	if !migrator.HasTable(&model.ResourceAdjustment{}) || migrator.HasColumn(&model.ResourceAdjustment{}, "OperatorName") {
+		return nil
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("ALTER TABLE resource_adjustments ADD COLUMN operator_name text").Error; err != nil {
+			return err
+		}
+		if err := tx.Exec("ALTER TABLE resource_adjustments ALTER COLUMN actor_id DROP NOT NULL").Error; err != nil {
+			return err
+		}
+		return tx.Exec("UPDATE resource_adjustments SET operator_name = substring(actor_id from 5), actor_id = NULL WHERE actor_id LIKE 'cli:%'").Error
+	})
+}
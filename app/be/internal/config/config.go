@@ -3,12 +3,15 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"time"
 
+	"mwce-be/internal/util"
+
 	"gopkg.in/yaml.v3"
 )
 
@@ -18,6 +21,7 @@ type Config struct {
 	Server      ServerConfig `yaml:"server"`
 	Database    DBConfig     `yaml:"database"`
 	JWT         JWTConfig    `yaml:"jwt"`
+	Admin       AdminConfig  `yaml:"admin"`
 	Game        *GameConfig  `yaml:"-"` // Loaded separately
 }
 
@@ -48,17 +52,25 @@ type JWTConfig struct {
 	TokenLifetime time.Duration `yaml:"token_lifetime"`
 }
 
+// AdminConfig holds the back-office configuration
+type AdminConfig struct {
+	BootstrapEmails []string `yaml:"bootstrap_emails"` // Accounts promoted to admin on startup
+}
+
 // GameConfig holds game-specific configuration
 type GameConfig struct {
-	MechanicsFile             string              `yaml:"mechanics_file"`
-	TerritoryStructureFile    string              `yaml:"territory_structure_file"`
-	OperationsFile            string              `yaml:"operations_file"`
-	DailyOperationsCount      int                 `yaml:"daily_operations_count"`
-	SpecialOperationsCount    int                 `yaml:"special_operations_count"`
-	OperationsRefreshInterval int                 `yaml:"operations_refresh_interval"`  // in minutes
-	MarketPriceUpdateInterval int                 `yaml:"market_price_update_interval"` // in minutes
-	ResourceLimit             ResourceLimitConfig `yaml:"resource_limit"`
-	Mechanics                 *MechanicsConfig    `yaml:"-"` // Loaded separately
+	MechanicsFile                string              `yaml:"mechanics_file"`
+	TerritoryStructureFile       string              `yaml:"territory_structure_file"`
+	OperationsFile               string              `yaml:"operations_file"`
+	DailyOperationsCount         int                 `yaml:"daily_operations_count"`
+	SpecialOperationsCount       int                 `yaml:"special_operations_count"`
+	OperationsRefreshInterval    int                 `yaml:"operations_refresh_interval"`    // in minutes
+	MarketPriceUpdateInterval    int                 `yaml:"market_price_update_interval"`   // in minutes
+	IllegalRotationInterval      int                 `yaml:"illegal_rotation_interval"`      // in minutes
+	AnnouncementDeliveryInterval int                 `yaml:"announcement_delivery_interval"` // in seconds
+	ResourceLimit                ResourceLimitConfig `yaml:"resource_limit"`
+	Mechanics                    *MechanicsConfig    `yaml:"-"` // Loaded separately
+	ConfigDir                    string              `yaml:"-"` // Directory the game config was loaded from
 }
 
 // ResourceLimitConfig contains limits for game resources
@@ -162,6 +174,7 @@ func LoadAllConfigs(appConfigPath string) (*Config, error) {
 	fmt.Printf("  Mechanics File: %s\n", gameConfig.MechanicsFile)
 
 	// Set game config in main config
+	gameConfig.ConfigDir = baseDir
 	config.Game = gameConfig
 
 	mechanicsPath := filepath.Join(baseDir, "mechanics.yaml")
@@ -178,6 +191,48 @@ func LoadAllConfigs(appConfigPath string) (*Config, error) {
 	}
 	fmt.Printf("Mechanics config loaded successfully\n")
 
+	// Validate territory action tuning
+	if err := mechanicsConfig.TerritoryActions.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid territory actions config: %w", err)
+	}
+
+	// Validate neighborhood bonus tiers
+	if err := mechanicsConfig.Neighborhood.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid neighborhood bonuses config: %w", err)
+	}
+
+	// Validate fortification tracks
+	if err := mechanicsConfig.Fortifications.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid fortifications config: %w", err)
+	}
+
+	// Validate jail tuning
+	if err := mechanicsConfig.Jail.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid jail config: %w", err)
+	}
+
+	// Validate protection rules
+	if err := mechanicsConfig.Protection.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid protection config: %w", err)
+	}
+
+	// Validate pity curves
+	for category, curve := range mechanicsConfig.Pity {
+		if err := curve.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid pity config for %s: %w", category, err)
+		}
+	}
+
+	// Validate local heat tuning
+	if err := mechanicsConfig.LocalHeat.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid local heat config: %w", err)
+	}
+
+	// Validate police raid tuning
+	if err := mechanicsConfig.PoliceRaids.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid police raid config: %w", err)
+	}
+
 	// Verify market section is loaded
 	if mechanicsConfig.Market.PriceFluctuationRange == 0 {
 		fmt.Printf("WARNING: Market price fluctuation range not loaded (zero value)\n")
@@ -198,15 +253,23 @@ func LoadAllConfigs(appConfigPath string) (*Config, error) {
 
 // MechanicsConfig holds the game mechanics configuration
 type MechanicsConfig struct {
-	SuccessChances map[string]SuccessChance `yaml:"success_chances"`
-	DefenseValues  DefenseValues            `yaml:"defense_values"`
-	Income         IncomeConfig             `yaml:"income"`
-	Market         MarketConfig             `yaml:"market"`
-	Progression    ProgressionConfig        `yaml:"progression"`
-	Heat           HeatConfig               `yaml:"heat"`
-	Notifications  NotificationConfig       `yaml:"notifications"`
-	Operations     OperationsConfig         `yaml:"operations"`
-	Travel         TravelConfig             `yaml:"travel"`
+	SuccessChances   map[string]SuccessChance `yaml:"success_chances"`
+	DefenseValues    DefenseValues            `yaml:"defense_values"`
+	Income           IncomeConfig             `yaml:"income"`
+	Market           MarketConfig             `yaml:"market"`
+	Progression      ProgressionConfig        `yaml:"progression"`
+	Heat             HeatConfig               `yaml:"heat"`
+	Notifications    NotificationConfig       `yaml:"notifications"`
+	Operations       OperationsConfig         `yaml:"operations"`
+	Travel           TravelConfig             `yaml:"travel"`
+	TerritoryActions TerritoryActionsConfig   `yaml:"territory_actions"`
+	Neighborhood     NeighborhoodConfig       `yaml:"neighborhood_bonuses"`
+	Fortifications   FortificationConfig      `yaml:"fortifications"`
+	Jail             JailConfig               `yaml:"jail"`
+	Protection       ProtectionConfig         `yaml:"protection"`
+	Pity             map[string]PityCurve     `yaml:"pity"` // Keyed by failure streak category
+	LocalHeat        LocalHeatConfig          `yaml:"local_heat"`
+	PoliceRaids      PoliceRaidConfig         `yaml:"police_raids"`
 }
 
 // SuccessChance represents success chance configuration for an action
@@ -224,8 +287,9 @@ type DefenseValues struct {
 
 // IncomeConfig represents territory income generation configuration
 type IncomeConfig struct {
-	BaseRates   map[string]int     `yaml:"base_rates"`
-	Multipliers map[string]float64 `yaml:"multipliers"`
+	BaseRates        map[string]int     `yaml:"base_rates"`        // Hourly income per hotspot type
+	Multipliers      map[string]float64 `yaml:"multipliers"`       // Per business type
+	TitleMultipliers map[string]float64 `yaml:"title_multipliers"` // Per controller title, keyed like progression titles
 }
 
 // MarketConfig represents market configuration
@@ -245,15 +309,18 @@ type ProgressionConfig struct {
 
 // HeatConfig represents heat mechanics configuration
 type HeatConfig struct {
-	DecayRate int                               `yaml:"decay_rate"`
-	MaxHeat   int                               `yaml:"max_heat"`
-	Effects   map[string]map[string]map[int]int `yaml:"effects"`
+	DecayRate         int                               `yaml:"decay_rate"`
+	MaxHeat           int                               `yaml:"max_heat"`
+	UpdateInterval    int                               `yaml:"update_interval"`    // in seconds
+	WarningThresholds []int                             `yaml:"warning_thresholds"` // Heat levels that trigger a warning when crossed
+	Effects           map[string]map[string]map[int]int `yaml:"effects"`
 }
 
 // NotificationConfig represents notification settings
 type NotificationConfig struct {
-	MaxUnread int `yaml:"max_unread"`
-	MaxTotal  int `yaml:"max_total"`
+	MaxUnread       int `yaml:"max_unread"`
+	MaxTotal        int `yaml:"max_total"`
+	IncomeThreshold int `yaml:"income_threshold"` // Income earned in one go that is worth a notification
 }
 
 // OperationsConfig represents operation generation settings
@@ -275,4 +342,557 @@ type TravelConfig struct {
 	MaxFinePercent       float64 `yaml:"max_fine_percent"`       // Maximum fine as percentage of player's money
 	CaughtHeatIncrease   int     `yaml:"caught_heat_increase"`   // Heat increase when caught
 	SuccessHeatReduction int     `yaml:"success_heat_reduction"` // Heat reduction on successful travel
+	CostPerDistance      int     `yaml:"cost_per_distance"`      // Added to the base cost of each hop for every unit of distance
+	CatchChancePerHop    float64 `yaml:"catch_chance_per_hop"`   // Extra catch chance for every hop after the first (percentage)
+	TimePerDistance      int     `yaml:"time_per_distance"`      // Seconds of travel for every unit of distance
+	MinTravelTime        int     `yaml:"min_travel_time"`        // Shortest possible trip in seconds
+	VehicleSpeedup       float64 `yaml:"vehicle_speedup"`        // Percent of travel time saved per vehicle owned
+	MaxVehicleSpeedup    float64 `yaml:"max_vehicle_speedup"`    // Most travel time vehicles can save (percentage)
+}
+
+// TerritoryActionsConfig holds the tuning for the territory action handlers
+type TerritoryActionsConfig struct {
+	OpposedStrengthTiers   []StrengthTier   `yaml:"opposed_strength_tiers"`   // Threshold is the attacker/defender strength ratio
+	UnopposedStrengthTiers []StrengthTier   `yaml:"unopposed_strength_tiers"` // Threshold is the committed strength
+	Extortion              ExtortionConfig  `yaml:"extortion"`
+	Takeover               TakeoverConfig   `yaml:"takeover"`
+	Collection             CollectionConfig `yaml:"collection"`
+	Defend                 DefendConfig     `yaml:"defend"`
+	Garrison               GarrisonConfig   `yaml:"garrison"`
+	Assault                AssaultConfig    `yaml:"assault"`
+}
+
+// AssaultConfig holds the staged assault tuning
+type AssaultConfig struct {
+	PreparationTime int `yaml:"preparation_time"` // Seconds between launching an assault and its resolution
+	SpotDelay       int `yaml:"spot_delay"`       // Seconds before the defender spots the assault, shortened by lookouts
+}
+
+// GarrisonConfig holds the garrison management tuning
+type GarrisonConfig struct {
+	CrossRegionTransferTime int `yaml:"cross_region_transfer_time"` // in seconds
+}
+
+// StrengthTier is a success chance bonus granted once a strength threshold is reached
+type StrengthTier struct {
+	Threshold float64 `yaml:"threshold"`
+	Bonus     int     `yaml:"bonus"`
+	Label     string  `yaml:"label"`
+}
+
+// IntRange is an inclusive range of whole numbers
+type IntRange struct {
+	Min int `yaml:"min"`
+	Max int `yaml:"max"`
+}
+
+// ResourceRoll is a percentage chance of gaining or losing an amount of a resource.
+// For losses a zero max means up to everything that was committed.
+type ResourceRoll struct {
+	Chance int      `yaml:"chance"`
+	Amount IntRange `yaml:"amount"`
+}
+
+// ResourceRolls groups the rolls for each committable resource
+type ResourceRolls struct {
+	Crew     ResourceRoll `yaml:"crew"`
+	Weapons  ResourceRoll `yaml:"weapons"`
+	Vehicles ResourceRoll `yaml:"vehicles"`
+}
+
+// ResourceWeights weights each committable resource
+type ResourceWeights struct {
+	Crew     float64 `yaml:"crew"`
+	Weapons  float64 `yaml:"weapons"`
+	Vehicles float64 `yaml:"vehicles"`
+}
+
+// ExtortionConfig holds the extortion tuning
+type ExtortionConfig struct {
+	BaseChance      int             `yaml:"base_chance"`
+	BaseGain        IntRange        `yaml:"base_gain"`
+	GainStep        int             `yaml:"gain_step"`      // Base gain is rolled in steps of this amount
+	PayoutWeights   ResourceWeights `yaml:"payout_weights"` // Committed resources raise the payout by weight / divisor
+	PayoutDivisor   float64         `yaml:"payout_divisor"`
+	BonusDropChance int             `yaml:"bonus_drop_chance"` // Chance to roll for bonus drops at all
+	BonusDrops      ResourceRolls   `yaml:"bonus_drops"`
+	SuccessHeat     IntRange        `yaml:"success_heat"`
+	SuccessRespect  IntRange        `yaml:"success_respect"`
+	FailureLosses   ResourceRolls   `yaml:"failure_losses"`
+	FailureHeat     IntRange        `yaml:"failure_heat"`
+}
+
+// TakeoverConfig holds the takeover tuning
+type TakeoverConfig struct {
+	BaseChanceControlled   int            `yaml:"base_chance_controlled"`
+	BaseChanceUncontrolled int            `yaml:"base_chance_uncontrolled"`
+	SuccessRespect         IntRange       `yaml:"success_respect"`
+	SuccessInfluence       IntRange       `yaml:"success_influence"`
+	SuccessHeat            IntRange       `yaml:"success_heat"`
+	FailureLosses          ResourceRolls  `yaml:"failure_losses"`
+	FailureHeat            IntRange       `yaml:"failure_heat"`
+	FailureRespectLoss     IntRange       `yaml:"failure_respect_loss"`
+	GarrisonSplit          GarrisonSplit  `yaml:"garrison_split"`
+	Underdog               UnderdogConfig `yaml:"underdog"`
+}
+
+// UnderdogConfig weighs the power of both sides of a contested takeover
+type UnderdogConfig struct {
+	PowerWeights UnderdogPowerWeights `yaml:"power_weights"`
+	Tiers        []UnderdogTier       `yaml:"tiers"` // Threshold is the defender/attacker power ratio
+}
+
+// UnderdogPowerWeights converts a player's standing into a single power score
+type UnderdogPowerWeights struct {
+	Respect   float64 `yaml:"respect"`
+	Influence float64 `yaml:"influence"`
+	Hotspot   float64 `yaml:"hotspot"`    // Per controlled hotspot
+	TitleRank float64 `yaml:"title_rank"` // Per title rank
+}
+
+// UnderdogTier adjusts the success chance and rewards once the defender is enough stronger than the attacker
+type UnderdogTier struct {
+	Threshold        float64 `yaml:"threshold"`
+	Label            string  `yaml:"label"`
+	ChanceBonus      int     `yaml:"chance_bonus"`
+	RewardMultiplier float64 `yaml:"reward_multiplier"` // Applied to respect and influence gained
+}
+
+// GarrisonSplit decides what happens to a defeated garrison, in percent of each resource
+type GarrisonSplit struct {
+	Destroyed int `yaml:"destroyed"`
+	Captured  int `yaml:"captured"` // Taken by the attacker
+	Fled      int `yaml:"fled"`     // Returned to the defender
+}
+
+// CollectionConfig holds the collection tuning
+type CollectionConfig struct {
+	BaseChance         int           `yaml:"base_chance"`
+	PendingPenaltyStep int           `yaml:"pending_penalty_step"` // Every this much pending money lowers the base chance by 1
+	MinBaseChance      int           `yaml:"min_base_chance"`
+	SuccessHeat        IntRange      `yaml:"success_heat"`
+	FailureLossPercent IntRange      `yaml:"failure_loss_percent"` // Share of pending money lost on failure
+	FailureLosses      ResourceRolls `yaml:"failure_losses"`
+	FailureHeat        IntRange      `yaml:"failure_heat"`
+}
+
+// DefendConfig holds the defend tuning
+type DefendConfig struct {
+	MaxGarrison GarrisonLimits `yaml:"max_garrison"` // Most of each resource a business can hold, 0 means no limit
+	SuccessHeat IntRange       `yaml:"success_heat"`
+}
+
+// GarrisonLimits caps each resource a hotspot can hold
+type GarrisonLimits struct {
+	Crew     int `yaml:"crew"`
+	Weapons  int `yaml:"weapons"`
+	Vehicles int `yaml:"vehicles"`
+}
+
+// Validate checks that every chance, range and tier in the territory actions config is usable
+func (c *TerritoryActionsConfig) Validate() error {
+	if err := validateStrengthTiers("opposed_strength_tiers", c.OpposedStrengthTiers); err != nil {
+		return err
+	}
+	if err := validateStrengthTiers("unopposed_strength_tiers", c.UnopposedStrengthTiers); err != nil {
+		return err
+	}
+
+	chances := map[string]int{
+		"extortion.base_chance":               c.Extortion.BaseChance,
+		"extortion.bonus_drop_chance":         c.Extortion.BonusDropChance,
+		"takeover.base_chance_controlled":     c.Takeover.BaseChanceControlled,
+		"takeover.base_chance_uncontrolled":   c.Takeover.BaseChanceUncontrolled,
+		"collection.base_chance":              c.Collection.BaseChance,
+		"collection.min_base_chance":          c.Collection.MinBaseChance,
+		"collection.failure_loss_percent.min": c.Collection.FailureLossPercent.Min,
+		"collection.failure_loss_percent.max": c.Collection.FailureLossPercent.Max,
+	}
+	for name, chance := range chances {
+		if chance < 0 || chance > 100 {
+			return fmt.Errorf("%s must be between 0 and 100", name)
+		}
+	}
+
+	ranges := map[string]IntRange{
+		"extortion.base_gain":             c.Extortion.BaseGain,
+		"extortion.success_heat":          c.Extortion.SuccessHeat,
+		"extortion.success_respect":       c.Extortion.SuccessRespect,
+		"extortion.failure_heat":          c.Extortion.FailureHeat,
+		"takeover.success_respect":        c.Takeover.SuccessRespect,
+		"takeover.success_influence":      c.Takeover.SuccessInfluence,
+		"takeover.success_heat":           c.Takeover.SuccessHeat,
+		"takeover.failure_heat":           c.Takeover.FailureHeat,
+		"takeover.failure_respect_loss":   c.Takeover.FailureRespectLoss,
+		"collection.success_heat":         c.Collection.SuccessHeat,
+		"collection.failure_heat":         c.Collection.FailureHeat,
+		"collection.failure_loss_percent": c.Collection.FailureLossPercent,
+		"defend.success_heat":             c.Defend.SuccessHeat,
+	}
+	for name, r := range ranges {
+		if err := r.validate(name); err != nil {
+			return err
+		}
+	}
+
+	rolls := map[string]ResourceRolls{
+		"extortion.bonus_drops":     c.Extortion.BonusDrops,
+		"extortion.failure_losses":  c.Extortion.FailureLosses,
+		"takeover.failure_losses":   c.Takeover.FailureLosses,
+		"collection.failure_losses": c.Collection.FailureLosses,
+	}
+	for name, r := range rolls {
+		if err := r.validate(name); err != nil {
+			return err
+		}
+	}
+
+	split := c.Takeover.GarrisonSplit
+	if split.Destroyed < 0 || split.Captured < 0 || split.Fled < 0 {
+		return errors.New("takeover.garrison_split cannot be negative")
+	}
+	if split.Destroyed+split.Captured+split.Fled != 100 {
+		return errors.New("takeover.garrison_split must add up to 100")
+	}
+
+	limits := c.Defend.MaxGarrison
+	if limits.Crew < 0 || limits.Weapons < 0 || limits.Vehicles < 0 {
+		return errors.New("defend.max_garrison cannot be negative")
+	}
+
+	if c.Garrison.CrossRegionTransferTime < 0 {
+		return errors.New("garrison.cross_region_transfer_time cannot be negative")
+	}
+
+	if c.Assault.PreparationTime <= 0 {
+		return errors.New("assault.preparation_time must be positive")
+	}
+	if c.Assault.SpotDelay < 0 || c.Assault.SpotDelay > c.Assault.PreparationTime {
+		return errors.New("assault.spot_delay must be between 0 and preparation_time")
+	}
+
+	tiers := c.Takeover.Underdog.Tiers
+	for i, tier := range tiers {
+		if tier.RewardMultiplier < 0 {
+			return errors.New("takeover.underdog.tiers reward_multiplier cannot be negative")
+		}
+		if i > 0 && tier.Threshold >= tiers[i-1].Threshold {
+			return errors.New("takeover.underdog.tiers must be ordered by descending threshold")
+		}
+	}
+
+	if c.Extortion.GainStep <= 0 {
+		return errors.New("extortion.gain_step must be positive")
+	}
+	if c.Extortion.PayoutDivisor <= 0 {
+		return errors.New("extortion.payout_divisor must be positive")
+	}
+	if c.Collection.PendingPenaltyStep <= 0 {
+		return errors.New("collection.pending_penalty_step must be positive")
+	}
+
+	return nil
+}
+
+// validate checks that a range is non-negative and ordered
+func (r IntRange) validate(name string) error {
+	if r.Min < 0 || r.Max < r.Min {
+		return fmt.Errorf("%s must have 0 <= min <= max", name)
+	}
+	return nil
+}
+
+// validate checks every resource roll in the group
+func (r ResourceRolls) validate(name string) error {
+	for resource, roll := range map[string]ResourceRoll{"crew": r.Crew, "weapons": r.Weapons, "vehicles": r.Vehicles} {
+		if roll.Chance < 0 || roll.Chance > 100 {
+			return fmt.Errorf("%s.%s.chance must be between 0 and 100", name, resource)
+		}
+		if roll.Amount.Min < 0 || (roll.Amount.Max != 0 && roll.Amount.Max < roll.Amount.Min) {
+			return fmt.Errorf("%s.%s.amount must have 0 <= min <= max", name, resource)
+		}
+	}
+	return nil
+}
+
+// validateStrengthTiers checks that tiers exist and are ordered from the highest threshold down
+func validateStrengthTiers(name string, tiers []StrengthTier) error {
+	if len(tiers) == 0 {
+		return fmt.Errorf("%s must not be empty", name)
+	}
+	for i := 1; i < len(tiers); i++ {
+		if tiers[i].Threshold >= tiers[i-1].Threshold {
+			return fmt.Errorf("%s must be ordered by descending threshold", name)
+		}
+	}
+	return nil
+}
+
+// NeighborhoodConfig holds the bonuses for controlling many hotspots in the same area
+type NeighborhoodConfig struct {
+	InfluenceInterval int                     `yaml:"influence_interval"` // in minutes
+	Tiers             []NeighborhoodBonusTier `yaml:"tiers"`
+}
+
+// NeighborhoodBonusTier is a bonus granted for controlling enough of an area's legal hotspots.
+// Within a scope the first tier a player qualifies for applies, so list them strongest first.
+type NeighborhoodBonusTier struct {
+	ID               string  `yaml:"id"`
+	Name             string  `yaml:"name"`
+	Scope            string  `yaml:"scope"`          // city, district or region
+	MinControlled    int     `yaml:"min_controlled"` // Minimum number of hotspots controlled
+	MinPercent       int     `yaml:"min_percent"`    // Minimum share of the area's legal hotspots
+	IncomeMultiplier float64 `yaml:"income_multiplier"`
+	DefenseBonus     int     `yaml:"defense_bonus"` // Percentage added to hotspot defense
+	InfluencePerHour int     `yaml:"influence_per_hour"`
+}
+
+// Validate checks that every neighborhood bonus tier is usable
+func (c *NeighborhoodConfig) Validate() error {
+	seen := make(map[string]bool, len(c.Tiers))
+	for _, tier := range c.Tiers {
+		if tier.ID == "" {
+			return errors.New("every tier needs an id")
+		}
+		if seen[tier.ID] {
+			return fmt.Errorf("tier %s is defined more than once", tier.ID)
+		}
+		seen[tier.ID] = true
+
+		switch tier.Scope {
+		case util.NeighborhoodScopeCity, util.NeighborhoodScopeDistrict, util.NeighborhoodScopeRegion:
+		default:
+			return fmt.Errorf("tier %s has unknown scope %q", tier.ID, tier.Scope)
+		}
+
+		if tier.MinControlled < 1 {
+			return fmt.Errorf("tier %s min_controlled must be at least 1", tier.ID)
+		}
+		if tier.MinPercent < 0 || tier.MinPercent > 100 {
+			return fmt.Errorf("tier %s min_percent must be between 0 and 100", tier.ID)
+		}
+		if tier.IncomeMultiplier != 0 && tier.IncomeMultiplier < 1 {
+			return fmt.Errorf("tier %s income_multiplier must be at least 1", tier.ID)
+		}
+		if tier.DefenseBonus < 0 || tier.InfluencePerHour < 0 {
+			return fmt.Errorf("tier %s bonuses cannot be negative", tier.ID)
+		}
+	}
+
+	if len(c.Tiers) > 0 && c.InfluenceInterval <= 0 {
+		return errors.New("influence_interval must be positive")
+	}
+
+	return nil
+}
+
+// FortificationConfig holds the upgrade tracks players can buy for their hotspots
+type FortificationConfig struct {
+	TakeoverLevelLoss int                           `yaml:"takeover_level_loss"` // Percent of each track's levels lost on takeover, rounded up
+	Tracks            map[string]FortificationTrack `yaml:"tracks"`
+}
+
+// FortificationTrack is a single upgrade track such as walls or lookouts
+type FortificationTrack struct {
+	Name        string               `yaml:"name"`
+	Description string               `yaml:"description"`
+	Levels      []FortificationLevel `yaml:"levels"`
+}
+
+// FortificationLevel is one level of a track. Effects are the totals at that level, not increments.
+type FortificationLevel struct {
+	Cost                   int `yaml:"cost"`
+	BuildTime              int `yaml:"build_time"`               // in seconds
+	DefenseFlat            int `yaml:"defense_flat"`             // Added to defense strength
+	DefensePercent         int `yaml:"defense_percent"`          // Percentage added to defense strength
+	CollectionBonus        int `yaml:"collection_bonus"`         // Added to the collection success chance
+	WarningWindowReduction int `yaml:"warning_window_reduction"` // Percent the delay before an attack is spotted is shortened by
+}
+
+// Validate checks that every fortification track is usable
+func (c *FortificationConfig) Validate() error {
+	if c.TakeoverLevelLoss < 0 || c.TakeoverLevelLoss > 100 {
+		return errors.New("takeover_level_loss must be between 0 and 100")
+	}
+
+	for id, track := range c.Tracks {
+		if len(track.Levels) == 0 {
+			return fmt.Errorf("track %s needs at least one level", id)
+		}
+		for i, level := range track.Levels {
+			if level.Cost < 0 || level.BuildTime < 0 {
+				return fmt.Errorf("track %s level %d cannot have a negative cost or build time", id, i+1)
+			}
+			if level.DefenseFlat < 0 || level.DefensePercent < 0 || level.CollectionBonus < 0 {
+				return fmt.Errorf("track %s level %d cannot have negative effects", id, i+1)
+			}
+			if level.WarningWindowReduction < 0 || level.WarningWindowReduction > 100 {
+				return fmt.Errorf("track %s level %d warning_window_reduction must be between 0 and 100", id, i+1)
+			}
+		}
+	}
+
+	return nil
+}
+
+// JailConfig tunes arrests and the ways out of jail
+type JailConfig struct {
+	ArrestChance         float64         `yaml:"arrest_chance"`          // Chance a police stop ends in arrest (percentage)
+	ArrestHeatMultiplier float64         `yaml:"arrest_heat_multiplier"` // Extra arrest chance per point of heat
+	MaxArrestChance      float64         `yaml:"max_arrest_chance"`
+	Duration             int             `yaml:"duration"`          // Base sentence in seconds
+	DurationPerHeat      int             `yaml:"duration_per_heat"` // Extra seconds per point of heat
+	MaxDuration          int             `yaml:"max_duration"`      // in seconds
+	BailPerMinute        int             `yaml:"bail_per_minute"`   // Bail cost per minute of sentence left
+	MinBail              int             `yaml:"min_bail"`
+	Bribe                JailBribeConfig `yaml:"bribe"`
+}
+
+// JailBribeConfig tunes bribing a judge for an early release
+type JailBribeConfig struct {
+	Cost               int     `yaml:"cost"`
+	CostPerInfluence   int     `yaml:"cost_per_influence"` // Discount per point of influence
+	MinCost            int     `yaml:"min_cost"`
+	BaseChance         float64 `yaml:"base_chance"`
+	ChancePerInfluence float64 `yaml:"chance_per_influence"`
+	MaxChance          float64 `yaml:"max_chance"`
+	FailureExtraTime   int     `yaml:"failure_extra_time"` // Seconds added to the sentence when the judge refuses
+	FailureHeat        int     `yaml:"failure_heat"`
+}
+
+// Validate checks the jail tuning is usable
+func (c *JailConfig) Validate() error {
+	if c.ArrestChance < 0 || c.MaxArrestChance < 0 || c.MaxArrestChance > 100 {
+		return errors.New("arrest chances must be between 0 and 100")
+	}
+	if c.Duration < 0 || c.DurationPerHeat < 0 || c.MaxDuration < 0 {
+		return errors.New("jail durations cannot be negative")
+	}
+	if c.Bribe.MaxChance < 0 || c.Bribe.MaxChance > 100 {
+		return errors.New("bribe max_chance must be between 0 and 100")
+	}
+	return nil
+}
+
+// ProtectionConfig sets when a player counts as having suffered a major loss and how long the shield lasts
+type ProtectionConfig struct {
+	Duration            int `yaml:"duration"`              // Shield length in seconds
+	LossWindow          int `yaml:"loss_window"`           // Seconds to look back for lost hotspots
+	HotspotLossPercent  int `yaml:"hotspot_loss_percent"`  // Share of hotspots lost within the window
+	MinHotspotsLost     int `yaml:"min_hotspots_lost"`     // Losing fewer than this never counts
+	ResourceWipePercent int `yaml:"resource_wipe_percent"` // Share of crew, weapons and vehicles lost in one failed takeover
+	MinResourcesLost    int `yaml:"min_resources_lost"`
+}
+
+// Validate checks the protection rules are usable
+func (c *ProtectionConfig) Validate() error {
+	if c.Duration < 0 || c.LossWindow < 0 {
+		return errors.New("protection durations cannot be negative")
+	}
+	if c.HotspotLossPercent < 0 || c.HotspotLossPercent > 100 || c.ResourceWipePercent < 0 || c.ResourceWipePercent > 100 {
+		return errors.New("protection loss percentages must be between 0 and 100")
+	}
+	return nil
+}
+
+// PityCurve sets how the success bonus grows with consecutive failures
+type PityCurve struct {
+	StartAfter      int `yaml:"start_after"`       // Failures in a row before the bonus kicks in
+	BonusPerFailure int `yaml:"bonus_per_failure"` // Percentage points per failure from then on
+	MaxBonus        int `yaml:"max_bonus"`
+}
+
+// Validate checks the pity curve is usable
+func (c *PityCurve) Validate() error {
+	if c.StartAfter < 1 {
+		return errors.New("start_after must be at least 1")
+	}
+	if c.BonusPerFailure < 0 || c.MaxBonus < 0 {
+		return errors.New("pity bonuses cannot be negative")
+	}
+	return nil
+}
+
+// LocalHeatConfig tunes the police attention cities and regions build up from the actions performed there
+type LocalHeatConfig struct {
+	MaxHeat              int             `yaml:"max_heat"`
+	ActionShare          int             `yaml:"action_share"`            // Percent of an action's heat that lands on its city
+	RegionShare          int             `yaml:"region_share"`            // Percent of the city's gain that also lands on its region
+	DecayAmount          int             `yaml:"decay_amount"`            // Points removed per decay pass
+	DecayInterval        int             `yaml:"decay_interval"`          // Seconds between decay passes
+	CatchChancePerHeat   float64         `yaml:"catch_chance_per_heat"`   // Extra travel catch chance per point of region heat
+	ActionPenaltyPerHeat float64         `yaml:"action_penalty_per_heat"` // Territory success penalty per point of city heat
+	MaxActionPenalty     int             `yaml:"max_action_penalty"`
+	PayoutPenaltyPerHeat float64         `yaml:"payout_penalty_per_heat"` // Percent off extortion payouts per point of city heat
+	MaxPayoutPenalty     int             `yaml:"max_payout_penalty"`
+	Crackdown            CrackdownConfig `yaml:"crackdown"`
+}
+
+// CrackdownConfig tunes the police crackdowns that freeze a city's illegal businesses
+type CrackdownConfig struct {
+	Threshold  int `yaml:"threshold"`   // City heat that sets off a crackdown, 0 disables crackdowns
+	Duration   int `yaml:"duration"`    // Seconds illegal businesses stay frozen
+	HeatRelief int `yaml:"heat_relief"` // City heat removed when a crackdown starts
+}
+
+// Validate checks the local heat tuning is usable
+func (c *LocalHeatConfig) Validate() error {
+	if c.MaxHeat <= 0 {
+		return errors.New("max_heat must be positive")
+	}
+	if c.ActionShare < 0 || c.RegionShare < 0 || c.RegionShare > 100 {
+		return errors.New("action_share cannot be negative and region_share must be between 0 and 100")
+	}
+	if c.DecayAmount < 0 || c.DecayInterval <= 0 {
+		return errors.New("decay_amount cannot be negative and decay_interval must be positive")
+	}
+	if c.MaxPayoutPenalty < 0 || c.MaxPayoutPenalty > 100 {
+		return errors.New("max_payout_penalty must be between 0 and 100")
+	}
+	if c.Crackdown.Threshold < 0 || c.Crackdown.Duration < 0 || c.Crackdown.HeatRelief < 0 {
+		return errors.New("crackdown settings cannot be negative")
+	}
+	return nil
+}
+
+// PoliceRaidConfig tunes the police raids on the businesses of players with a lot of heat
+type PoliceRaidConfig struct {
+	Interval      int        `yaml:"interval"`        // Seconds between raid passes
+	MinHeat       int        `yaml:"min_heat"`        // Players below this heat are never raided
+	BaseChance    float64    `yaml:"base_chance"`     // Percent chance per pass of a raid at min_heat
+	ChancePerHeat float64    `yaml:"chance_per_heat"` // Extra percent chance per point of heat above min_heat
+	MaxChance     float64    `yaml:"max_chance"`      // Raid chance never exceeds this
+	SeizePercent  IntRange   `yaml:"seize_percent"`   // Percent of the pending collection seized
+	GarrisonLoss  IntRange   `yaml:"garrison_loss"`   // Percent of each garrison resource destroyed
+	CloseChance   int        `yaml:"close_chance"`    // Percent chance the business is shut down
+	CloseDuration int        `yaml:"close_duration"`  // Seconds a shut down business stays closed
+	Bribes        RaidBribes `yaml:"bribes"`
+	Seed          int64      `yaml:"seed"` // Fixed random seed for reproducible raids, 0 seeds from the clock
+}
+
+// RaidBribes tunes how completed official bribing operations keep the police away
+type RaidBribes struct {
+	CoverDuration   int `yaml:"cover_duration"`   // Seconds a completed bribe keeps working
+	ChanceReduction int `yaml:"chance_reduction"` // Percent off raid odds per bribe still working
+	MaxReduction    int `yaml:"max_reduction"`
+}
+
+// Validate checks the police raid tuning is usable
+func (c *PoliceRaidConfig) Validate() error {
+	if c.Interval <= 0 {
+		return errors.New("interval must be positive")
+	}
+	if c.BaseChance < 0 || c.ChancePerHeat < 0 || c.MaxChance < 0 || c.MaxChance > 100 {
+		return errors.New("raid chances cannot be negative and max_chance cannot exceed 100")
+	}
+	if c.SeizePercent.Min < 0 || c.SeizePercent.Max > 100 || c.GarrisonLoss.Min < 0 || c.GarrisonLoss.Max > 100 {
+		return errors.New("seize_percent and garrison_loss must be between 0 and 100")
+	}
+	if c.CloseChance < 0 || c.CloseChance > 100 || c.CloseDuration < 0 {
+		return errors.New("close_chance must be between 0 and 100 and close_duration cannot be negative")
+	}
+	if c.Bribes.CoverDuration < 0 || c.Bribes.ChanceReduction < 0 || c.Bribes.MaxReduction < 0 || c.Bribes.MaxReduction > 100 {
+		return errors.New("bribe settings cannot be negative and max_reduction cannot exceed 100")
+	}
+	return nil
 }
@@ -0,0 +1,312 @@
+// internal/controller/admin.go
+
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"mwce-be/internal/middleware"
+	"mwce-be/internal/model"
+	"mwce-be/internal/service"
+	"mwce-be/internal/util"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+)
+
+// AdminController handles back-office HTTP requests
+type AdminController struct {
+	adminService service.AdminService
+	logger       zerolog.Logger
+}
+
+// NewAdminController creates a new admin controller
+func NewAdminController(adminService service.AdminService, logger zerolog.Logger) *AdminController {
+	return &AdminController{
+		adminService: adminService,
+		logger:       logger,
+	}
+}
+
+// GetAuditLogs handles getting the admin audit log
+func (c *AdminController) GetAuditLogs(w http.ResponseWriter, r *http.Request) {
+	// Get optional filters from the query string
+	actorID := r.URL.Query().Get("actorId")
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	// Get the audit log entries
+	entries, err := c.adminService.GetAuditLogs(actorID, limit)
+	if err != nil {
+		c.logger.Error().Err(err).Msg("Failed to get audit logs")
+		util.RespondWithError(w, http.StatusInternalServerError, "Failed to get audit logs")
+		return
+	}
+
+	// Return success response
+	util.RespondWithJSON(w, http.StatusOK, entries)
+}
+
+// UpdatePlayerRole handles changing a player's role
+func (c *AdminController) UpdatePlayerRole(w http.ResponseWriter, r *http.Request) {
+	// Get actor ID from context
+	actorID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		util.RespondWithError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	// Get player ID from URL
+	playerID := chi.URLParam(r, "id")
+	if playerID == "" {
+		util.RespondWithError(w, http.StatusBadRequest, "Player ID is required")
+		return
+	}
+
+	// Parse request body
+	var request model.UpdateRoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		util.RespondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Update the role
+	player, err := c.adminService.UpdatePlayerRole(actorID, playerID, request.Role)
+	if err != nil {
+		c.logger.Error().Err(err).Str("playerID", playerID).Msg("Failed to update player role")
+		util.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Return success response
+	util.RespondWithJSON(w, http.StatusOK, player)
+}
+
+// SearchPlayers handles searching players by ID, name or email
+func (c *AdminController) SearchPlayers(w http.ResponseWriter, r *http.Request) {
+	// Get search parameters from the query string
+	query := r.URL.Query().Get("q")
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	// Search players
+	players, err := c.adminService.SearchPlayers(query, limit)
+	if err != nil {
+		c.logger.Error().Err(err).Msg("Failed to search players")
+		util.RespondWithError(w, http.StatusInternalServerError, "Failed to search players")
+		return
+	}
+
+	// Return success response
+	util.RespondWithJSON(w, http.StatusOK, players)
+}
+
+// GetPlayerState handles getting the full state of a player
+func (c *AdminController) GetPlayerState(w http.ResponseWriter, r *http.Request) {
+	// Get player ID from URL
+	playerID := chi.URLParam(r, "id")
+	if playerID == "" {
+		util.RespondWithError(w, http.StatusBadRequest, "Player ID is required")
+		return
+	}
+
+	// Get the player state
+	state, err := c.adminService.GetPlayerState(playerID)
+	if err != nil {
+		c.logger.Error().Err(err).Str("playerID", playerID).Msg("Failed to get player state")
+		util.RespondWithError(w, http.StatusNotFound, "Player not found")
+		return
+	}
+
+	// Return success response
+	util.RespondWithJSON(w, http.StatusOK, state)
+}
+
+// SuspendPlayer handles suspending a player
+func (c *AdminController) SuspendPlayer(w http.ResponseWriter, r *http.Request) {
+	c.handleSanction(w, r, c.adminService.SuspendPlayer)
+}
+
+// BanPlayer handles banning a player
+func (c *AdminController) BanPlayer(w http.ResponseWriter, r *http.Request) {
+	c.handleSanction(w, r, c.adminService.BanPlayer)
+}
+
+// LiftSanction handles lifting a suspension or ban
+func (c *AdminController) LiftSanction(w http.ResponseWriter, r *http.Request) {
+	// Get actor ID from context
+	actorID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		util.RespondWithError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	// Get player ID from URL
+	playerID := chi.URLParam(r, "id")
+	if playerID == "" {
+		util.RespondWithError(w, http.StatusBadRequest, "Player ID is required")
+		return
+	}
+
+	// Parse request body
+	var request model.AdminNoteRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		util.RespondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Lift the sanction
+	player, err := c.adminService.LiftSanction(actorID, playerID, request.Note)
+	if err != nil {
+		c.logger.Error().Err(err).Str("playerID", playerID).Msg("Failed to lift sanction")
+		util.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Return success response
+	util.RespondWithJSON(w, http.StatusOK, player)
+}
+
+// AdjustPlayerResources handles applying a manual resource adjustment
+func (c *AdminController) AdjustPlayerResources(w http.ResponseWriter, r *http.Request) {
+	// Get actor ID from context
+	actorID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		util.RespondWithError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	// Get player ID from URL
+	playerID := chi.URLParam(r, "id")
+	if playerID == "" {
+		util.RespondWithError(w, http.StatusBadRequest, "Player ID is required")
+		return
+	}
+
+	// Parse request body
+	var request model.ResourceAdjustmentRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		util.RespondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Apply the adjustment
+	player, err := c.adminService.AdjustPlayerResources(actorID, playerID, request.Resources, request.Note)
+	if err != nil {
+		c.logger.Error().Err(err).Str("playerID", playerID).Msg("Failed to adjust player resources")
+		util.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Return success response
+	util.RespondWithJSON(w, http.StatusOK, player)
+}
+
+// ResetPlayerResources handles resetting a player's resources to the starting values
+func (c *AdminController) ResetPlayerResources(w http.ResponseWriter, r *http.Request) {
+	// Get actor ID from context
+	actorID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		util.RespondWithError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	// Get player ID from URL
+	playerID := chi.URLParam(r, "id")
+	if playerID == "" {
+		util.RespondWithError(w, http.StatusBadRequest, "Player ID is required")
+		return
+	}
+
+	// Parse request body
+	var request model.AdminNoteRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		util.RespondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if request.Note == "" {
+		util.RespondWithError(w, http.StatusBadRequest, "A note is required")
+		return
+	}
+
+	// Reset the resources
+	player, err := c.adminService.ResetPlayerResources(actorID, playerID, request.Note)
+	if err != nil {
+		c.logger.Error().Err(err).Str("playerID", playerID).Msg("Failed to reset player resources")
+		util.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Return success response
+	util.RespondWithJSON(w, http.StatusOK, player)
+}
+
+// ReleaseHotspot handles forcing a hotspot back to neutral
+func (c *AdminController) ReleaseHotspot(w http.ResponseWriter, r *http.Request) {
+	// Get actor ID from context
+	actorID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		util.RespondWithError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	// Get hotspot ID from URL
+	hotspotID := chi.URLParam(r, "id")
+	if hotspotID == "" {
+		util.RespondWithError(w, http.StatusBadRequest, "Hotspot ID is required")
+		return
+	}
+
+	// Parse request body
+	var request model.AdminNoteRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		util.RespondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Release the hotspot
+	hotspot, err := c.adminService.ReleaseHotspot(actorID, hotspotID, request.Note)
+	if err != nil {
+		c.logger.Error().Err(err).Str("hotspotID", hotspotID).Msg("Failed to release hotspot")
+		util.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Return success response
+	util.RespondWithJSON(w, http.StatusOK, hotspot)
+}
+
+// handleSanction decodes a sanction request and applies it with the given action
+func (c *AdminController) handleSanction(w http.ResponseWriter, r *http.Request, apply func(actorID, playerID string, request model.SanctionRequest) (*model.Player, error)) {
+	// Get actor ID from context
+	actorID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		util.RespondWithError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	// Get player ID from URL
+	playerID := chi.URLParam(r, "id")
+	if playerID == "" {
+		util.RespondWithError(w, http.StatusBadRequest, "Player ID is required")
+		return
+	}
+
+	// Parse request body
+	var request model.SanctionRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		util.RespondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Apply the sanction
+	player, err := apply(actorID, playerID, request)
+	if err != nil {
+		c.logger.Error().Err(err).Str("playerID", playerID).Msg("Failed to sanction player")
+		util.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Return success response
+	util.RespondWithJSON(w, http.StatusOK, player)
+}
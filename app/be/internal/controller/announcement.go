@@ -0,0 +1,124 @@
+// internal/controller/announcement.go
+
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"mwce-be/internal/middleware"
+	"mwce-be/internal/model"
+	"mwce-be/internal/service"
+	"mwce-be/internal/util"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+)
+
+// AnnouncementController handles announcement-related HTTP requests
+type AnnouncementController struct {
+	announcementService service.AnnouncementService
+	logger              zerolog.Logger
+}
+
+// NewAnnouncementController creates a new announcement controller
+func NewAnnouncementController(announcementService service.AnnouncementService, logger zerolog.Logger) *AnnouncementController {
+	return &AnnouncementController{
+		announcementService: announcementService,
+		logger:              logger,
+	}
+}
+
+// GetAnnouncements handles getting the running announcements for the player
+func (c *AnnouncementController) GetAnnouncements(w http.ResponseWriter, r *http.Request) {
+	// Get player ID from context
+	playerID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		util.RespondWithError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	// Get the announcements
+	announcements, err := c.announcementService.GetActiveAnnouncements(playerID)
+	if err != nil {
+		c.logger.Error().Err(err).Msg("Failed to get announcements")
+		util.RespondWithError(w, http.StatusInternalServerError, "Failed to get announcements")
+		return
+	}
+
+	// Return success response
+	util.RespondWithJSON(w, http.StatusOK, announcements)
+}
+
+// GetAllAnnouncements handles getting every recent announcement for the back office
+func (c *AnnouncementController) GetAllAnnouncements(w http.ResponseWriter, r *http.Request) {
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	// Get the announcements
+	announcements, err := c.announcementService.GetAllAnnouncements(limit)
+	if err != nil {
+		c.logger.Error().Err(err).Msg("Failed to get announcements")
+		util.RespondWithError(w, http.StatusInternalServerError, "Failed to get announcements")
+		return
+	}
+
+	// Return success response
+	util.RespondWithJSON(w, http.StatusOK, announcements)
+}
+
+// CreateAnnouncement handles scheduling a new announcement
+func (c *AnnouncementController) CreateAnnouncement(w http.ResponseWriter, r *http.Request) {
+	// Get actor ID from context
+	actorID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		util.RespondWithError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	// Parse request body
+	var request model.CreateAnnouncementRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		util.RespondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Create the announcement
+	announcement, err := c.announcementService.CreateAnnouncement(actorID, request)
+	if err != nil {
+		c.logger.Error().Err(err).Msg("Failed to create announcement")
+		util.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Return success response
+	util.RespondWithJSON(w, http.StatusCreated, announcement)
+}
+
+// CancelAnnouncement handles cancelling an announcement
+func (c *AnnouncementController) CancelAnnouncement(w http.ResponseWriter, r *http.Request) {
+	// Get actor ID from context
+	actorID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		util.RespondWithError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	// Get announcement ID from URL
+	announcementID := chi.URLParam(r, "id")
+	if announcementID == "" {
+		util.RespondWithError(w, http.StatusBadRequest, "Announcement ID is required")
+		return
+	}
+
+	// Cancel the announcement
+	announcement, err := c.announcementService.CancelAnnouncement(actorID, announcementID)
+	if err != nil {
+		c.logger.Error().Err(err).Str("announcementID", announcementID).Msg("Failed to cancel announcement")
+		util.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Return success response
+	util.RespondWithJSON(w, http.StatusOK, announcement)
+}
@@ -4,6 +4,7 @@ package controller
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 
 	"mwce-be/internal/middleware"
@@ -99,6 +100,14 @@ func (c *AuthController) Login(w http.ResponseWriter, r *http.Request) {
 
 	// Authenticate the user
 	response, err := c.authService.Login(request)
+	switch {
+	case errors.Is(err, service.ErrAccountBanned):
+		util.RespondWithError(w, http.StatusForbidden, "Your account has been banned")
+		return
+	case errors.Is(err, service.ErrAccountSuspended):
+		util.RespondWithError(w, http.StatusForbidden, "Your account has been suspended")
+		return
+	}
 	if err != nil {
 		c.logger.Error().Err(err).Msg("Login failed")
 		util.RespondWithError(w, http.StatusUnauthorized, "Invalid credentials")
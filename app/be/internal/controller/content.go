@@ -0,0 +1,276 @@
+// internal/controller/content.go
+
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"mwce-be/internal/middleware"
+	"mwce-be/internal/model"
+	"mwce-be/internal/service"
+	"mwce-be/internal/util"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+)
+
+// ContentController handles live-ops content HTTP requests
+type ContentController struct {
+	contentService service.ContentService
+	logger         zerolog.Logger
+}
+
+// NewContentController creates a new content controller
+func NewContentController(contentService service.ContentService, logger zerolog.Logger) *ContentController {
+	return &ContentController{
+		contentService: contentService,
+		logger:         logger,
+	}
+}
+
+// CreateRegion handles creating a region
+func (c *ContentController) CreateRegion(w http.ResponseWriter, r *http.Request) {
+	var request model.RegionContentRequest
+	actorID, ok := c.decodeRequest(w, r, &request)
+	if !ok {
+		return
+	}
+
+	region, err := c.contentService.CreateRegion(actorID, request)
+	c.respond(w, http.StatusCreated, region, err, "Failed to create region")
+}
+
+// UpdateRegion handles updating a region
+func (c *ContentController) UpdateRegion(w http.ResponseWriter, r *http.Request) {
+	var request model.RegionContentRequest
+	actorID, ok := c.decodeRequest(w, r, &request)
+	if !ok {
+		return
+	}
+
+	region, err := c.contentService.UpdateRegion(actorID, chi.URLParam(r, "id"), request)
+	c.respond(w, http.StatusOK, region, err, "Failed to update region")
+}
+
+// RetireRegion handles retiring a region
+func (c *ContentController) RetireRegion(w http.ResponseWriter, r *http.Request) {
+	c.handleRetire(w, r, c.contentService.RetireRegion, "Failed to retire region")
+}
+
+// CreateDistrict handles creating a district
+func (c *ContentController) CreateDistrict(w http.ResponseWriter, r *http.Request) {
+	var request model.DistrictContentRequest
+	actorID, ok := c.decodeRequest(w, r, &request)
+	if !ok {
+		return
+	}
+
+	district, err := c.contentService.CreateDistrict(actorID, request)
+	c.respond(w, http.StatusCreated, district, err, "Failed to create district")
+}
+
+// UpdateDistrict handles updating a district
+func (c *ContentController) UpdateDistrict(w http.ResponseWriter, r *http.Request) {
+	var request model.DistrictContentRequest
+	actorID, ok := c.decodeRequest(w, r, &request)
+	if !ok {
+		return
+	}
+
+	district, err := c.contentService.UpdateDistrict(actorID, chi.URLParam(r, "id"), request)
+	c.respond(w, http.StatusOK, district, err, "Failed to update district")
+}
+
+// RetireDistrict handles retiring a district
+func (c *ContentController) RetireDistrict(w http.ResponseWriter, r *http.Request) {
+	c.handleRetire(w, r, c.contentService.RetireDistrict, "Failed to retire district")
+}
+
+// CreateCity handles creating a city
+func (c *ContentController) CreateCity(w http.ResponseWriter, r *http.Request) {
+	var request model.CityContentRequest
+	actorID, ok := c.decodeRequest(w, r, &request)
+	if !ok {
+		return
+	}
+
+	city, err := c.contentService.CreateCity(actorID, request)
+	c.respond(w, http.StatusCreated, city, err, "Failed to create city")
+}
+
+// UpdateCity handles updating a city
+func (c *ContentController) UpdateCity(w http.ResponseWriter, r *http.Request) {
+	var request model.CityContentRequest
+	actorID, ok := c.decodeRequest(w, r, &request)
+	if !ok {
+		return
+	}
+
+	city, err := c.contentService.UpdateCity(actorID, chi.URLParam(r, "id"), request)
+	c.respond(w, http.StatusOK, city, err, "Failed to update city")
+}
+
+// RetireCity handles retiring a city
+func (c *ContentController) RetireCity(w http.ResponseWriter, r *http.Request) {
+	c.handleRetire(w, r, c.contentService.RetireCity, "Failed to retire city")
+}
+
+// CreateHotspot handles creating a hotspot
+func (c *ContentController) CreateHotspot(w http.ResponseWriter, r *http.Request) {
+	var request model.HotspotContentRequest
+	actorID, ok := c.decodeRequest(w, r, &request)
+	if !ok {
+		return
+	}
+
+	hotspot, err := c.contentService.CreateHotspot(actorID, request)
+	c.respond(w, http.StatusCreated, hotspot, err, "Failed to create hotspot")
+}
+
+// UpdateHotspot handles updating a hotspot
+func (c *ContentController) UpdateHotspot(w http.ResponseWriter, r *http.Request) {
+	var request model.HotspotContentRequest
+	actorID, ok := c.decodeRequest(w, r, &request)
+	if !ok {
+		return
+	}
+
+	hotspot, err := c.contentService.UpdateHotspot(actorID, chi.URLParam(r, "id"), request)
+	c.respond(w, http.StatusOK, hotspot, err, "Failed to update hotspot")
+}
+
+// RetireHotspot handles retiring a hotspot
+func (c *ContentController) RetireHotspot(w http.ResponseWriter, r *http.Request) {
+	c.handleRetire(w, r, c.contentService.RetireHotspot, "Failed to retire hotspot")
+}
+
+// GetOperationTemplates handles getting the operations pool
+func (c *ContentController) GetOperationTemplates(w http.ResponseWriter, r *http.Request) {
+	templates, err := c.contentService.GetOperationTemplates()
+	c.respond(w, http.StatusOK, templates, err, "Failed to get operation templates")
+}
+
+// CreateOperationTemplate handles adding a template to the operations pool
+func (c *ContentController) CreateOperationTemplate(w http.ResponseWriter, r *http.Request) {
+	var template model.OperationTemplate
+	actorID, ok := c.decodeRequest(w, r, &template)
+	if !ok {
+		return
+	}
+
+	saved, err := c.contentService.CreateOperationTemplate(actorID, template)
+	c.respond(w, http.StatusCreated, saved, err, "Failed to create operation template")
+}
+
+// UpdateOperationTemplate handles editing a template in the operations pool
+func (c *ContentController) UpdateOperationTemplate(w http.ResponseWriter, r *http.Request) {
+	var template model.OperationTemplate
+	actorID, ok := c.decodeRequest(w, r, &template)
+	if !ok {
+		return
+	}
+
+	saved, err := c.contentService.UpdateOperationTemplate(actorID, chi.URLParam(r, "id"), template)
+	if errors.Is(err, service.ErrOperationTemplateNotFound) {
+		util.RespondWithError(w, http.StatusNotFound, "Operation template not found")
+		return
+	}
+	c.respond(w, http.StatusOK, saved, err, "Failed to update operation template")
+}
+
+// RetireOperationTemplate handles removing a template from the operations pool
+func (c *ContentController) RetireOperationTemplate(w http.ResponseWriter, r *http.Request) {
+	c.handleRetire(w, r, c.contentService.RetireOperationTemplate, "Failed to retire operation template")
+}
+
+// PublishCampaign handles publishing a new campaign
+func (c *ContentController) PublishCampaign(w http.ResponseWriter, r *http.Request) {
+	var campaign model.Campaign
+	actorID, ok := c.decodeRequest(w, r, &campaign)
+	if !ok {
+		return
+	}
+
+	published, err := c.contentService.PublishCampaign(actorID, campaign)
+	c.respond(w, http.StatusCreated, published, err, "Failed to publish campaign")
+}
+
+// UpdateCampaign handles updating campaign metadata
+func (c *ContentController) UpdateCampaign(w http.ResponseWriter, r *http.Request) {
+	var request model.CampaignContentRequest
+	actorID, ok := c.decodeRequest(w, r, &request)
+	if !ok {
+		return
+	}
+
+	campaign, err := c.contentService.UpdateCampaign(actorID, chi.URLParam(r, "id"), request)
+	c.respond(w, http.StatusOK, campaign, err, "Failed to update campaign")
+}
+
+// PublishChapter handles publishing a new chapter for a campaign
+func (c *ContentController) PublishChapter(w http.ResponseWriter, r *http.Request) {
+	var chapter model.Chapter
+	actorID, ok := c.decodeRequest(w, r, &chapter)
+	if !ok {
+		return
+	}
+
+	published, err := c.contentService.PublishChapter(actorID, chi.URLParam(r, "id"), chapter)
+	c.respond(w, http.StatusCreated, published, err, "Failed to publish chapter")
+}
+
+// GetContentVersions handles getting the content change history
+func (c *ContentController) GetContentVersions(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	limit, _ := strconv.Atoi(query.Get("limit"))
+
+	versions, err := c.contentService.GetContentVersions(query.Get("entityType"), query.Get("entityId"), limit)
+	c.respond(w, http.StatusOK, versions, err, "Failed to get content versions")
+}
+
+// handleRetire runs a retire action for the entity in the URL
+func (c *ContentController) handleRetire(w http.ResponseWriter, r *http.Request, retire func(actorID, id string) error, failureMessage string) {
+	// Get actor ID from context
+	actorID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		util.RespondWithError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	id := chi.URLParam(r, "id")
+	err := retire(actorID, id)
+	c.respond(w, http.StatusOK, map[string]string{"id": id, "status": "retired"}, err, failureMessage)
+}
+
+// decodeRequest gets the actor from context and decodes the JSON body
+func (c *ContentController) decodeRequest(w http.ResponseWriter, r *http.Request, request interface{}) (string, bool) {
+	// Get actor ID from context
+	actorID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		util.RespondWithError(w, http.StatusUnauthorized, "Unauthorized")
+		return "", false
+	}
+
+	// Parse request body
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+		util.RespondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return "", false
+	}
+
+	return actorID, true
+}
+
+// respond writes the result of a content action
+func (c *ContentController) respond(w http.ResponseWriter, status int, data interface{}, err error, failureMessage string) {
+	if err != nil {
+		c.logger.Error().Err(err).Msg(failureMessage)
+		util.RespondWithError(w, http.StatusBadRequest, failureMessage+": "+err.Error())
+		return
+	}
+
+	// Return success response
+	util.RespondWithJSON(w, status, data)
+}
@@ -0,0 +1,52 @@
+// internal/controller/heat.go
+
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"mwce-be/internal/middleware"
+	"mwce-be/internal/service"
+	"mwce-be/internal/util"
+
+	"github.com/rs/zerolog"
+)
+
+// HeatController handles heat-related HTTP requests
+type HeatController struct {
+	heatService service.HeatService
+	logger      zerolog.Logger
+}
+
+// NewHeatController creates a new heat controller
+func NewHeatController(heatService service.HeatService, logger zerolog.Logger) *HeatController {
+	return &HeatController{
+		heatService: heatService,
+		logger:      logger,
+	}
+}
+
+// GetHeatHistory handles getting the player's heat history
+func (c *HeatController) GetHeatHistory(w http.ResponseWriter, r *http.Request) {
+	// Get player ID from context
+	playerID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		util.RespondWithError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	// Get the time window from the query
+	hours, _ := strconv.Atoi(r.URL.Query().Get("hours"))
+
+	// Get the heat history
+	history, err := c.heatService.GetHeatHistory(playerID, hours)
+	if err != nil {
+		c.logger.Error().Err(err).Msg("Failed to get heat history")
+		util.RespondWithError(w, http.StatusInternalServerError, "Failed to get heat history")
+		return
+	}
+
+	// Return success response
+	util.RespondWithJSON(w, http.StatusOK, history)
+}
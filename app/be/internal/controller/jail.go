@@ -0,0 +1,94 @@
+// internal/controller/jail.go
+
+package controller
+
+import (
+	"net/http"
+
+	"mwce-be/internal/middleware"
+	"mwce-be/internal/service"
+	"mwce-be/internal/util"
+
+	"github.com/rs/zerolog"
+)
+
+// JailController handles jail-related HTTP requests
+type JailController struct {
+	jailService service.JailService
+	logger      zerolog.Logger
+}
+
+// NewJailController creates a new jail controller
+func NewJailController(jailService service.JailService, logger zerolog.Logger) *JailController {
+	return &JailController{
+		jailService: jailService,
+		logger:      logger,
+	}
+}
+
+// GetJailStatus handles getting the player's jail status
+func (c *JailController) GetJailStatus(w http.ResponseWriter, r *http.Request) {
+	// Get player ID from context
+	playerID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		util.RespondWithError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	// Get the jail status
+	status, err := c.jailService.GetJailStatus(playerID)
+	if err != nil {
+		c.logger.Error().Err(err).Msg("Failed to get jail status")
+		util.RespondWithError(w, http.StatusInternalServerError, "Failed to get jail status")
+		return
+	}
+
+	// Return success response
+	util.RespondWithJSON(w, http.StatusOK, status)
+}
+
+// PayBail handles paying bail to get out of jail
+func (c *JailController) PayBail(w http.ResponseWriter, r *http.Request) {
+	// Get player ID from context
+	playerID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		util.RespondWithError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	// Pay the bail
+	result, err := c.jailService.PayBail(playerID)
+	if err != nil {
+		c.logger.Error().Err(err).Msg("Failed to pay bail")
+		util.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Return success response with game message
+	util.RespondWithGameMessage(w, http.StatusOK, result, util.GameMessageTypeSuccess, result.Message)
+}
+
+// BribeJudge handles bribing a judge for an early release
+func (c *JailController) BribeJudge(w http.ResponseWriter, r *http.Request) {
+	// Get player ID from context
+	playerID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		util.RespondWithError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	// Attempt the bribe
+	result, err := c.jailService.BribeJudge(playerID)
+	if err != nil {
+		c.logger.Error().Err(err).Msg("Failed to bribe judge")
+		util.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Return response with a game message matching the outcome
+	messageType := util.GameMessageTypeSuccess
+	if !result.Released {
+		messageType = util.GameMessageTypeWarning
+	}
+	util.RespondWithGameMessage(w, http.StatusOK, result, messageType, result.Message)
+}
@@ -38,11 +38,12 @@ func (c *SSEController) HandleConnection(w http.ResponseWriter, r *http.Request)
 	}
 
 	// Validate token and get player ID
-	playerID, err := c.authService.ValidateToken(token) // You'll need to inject authService
+	claims, err := c.authService.ValidateToken(token)
 	if err != nil {
 		http.Error(w, "Unauthorized: Invalid token", http.StatusUnauthorized)
 		return
 	}
+	playerID := claims.UserID
 
 	// Add player ID to request context
 	ctx := context.WithValue(r.Context(), "userID", playerID)
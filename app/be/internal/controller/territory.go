@@ -4,6 +4,8 @@ package controller
 
 import (
 	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
 
 	"mwce-be/internal/middleware"
@@ -280,6 +282,9 @@ func (c *TerritoryController) PerformAction(w http.ResponseWriter, r *http.Reque
 		util.TerritoryActionTypeTakeover,
 		util.TerritoryActionTypeCollection,
 		util.TerritoryActionTypeDefend,
+		util.TerritoryActionTypeWithdraw,
+		util.TerritoryActionTypeTransfer,
+		util.TerritoryActionTypeAssault,
 	}
 
 	isValidAction := false
@@ -478,3 +483,158 @@ func (h *TerritoryController) CollectAllRegionalHotspotIncome(w http.ResponseWri
 		Int("hotspotsCount", result.HotspotsCount).
 		Msg("Successfully collected all regional hotspot income")
 }
+
+// GetGarrisonTransfers handles getting the player's garrison transfers still in transit
+func (c *TerritoryController) GetGarrisonTransfers(w http.ResponseWriter, r *http.Request) {
+	// Get player ID from context
+	playerID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		util.RespondWithError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	// Get the transfers
+	transfers, err := c.territoryService.GetGarrisonTransfers(playerID)
+	if err != nil {
+		c.logger.Error().Err(err).Msg("Failed to get garrison transfers")
+		util.RespondWithError(w, http.StatusInternalServerError, "Failed to get garrison transfers")
+		return
+	}
+
+	// Return success response
+	util.RespondWithJSON(w, http.StatusOK, transfers)
+}
+
+// GetAssaults handles getting the staged assaults a player is preparing or defending against
+func (c *TerritoryController) GetAssaults(w http.ResponseWriter, r *http.Request) {
+	// Get player ID from context
+	playerID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		util.RespondWithError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	// Get the assaults
+	assaults, err := c.territoryService.GetAssaults(playerID)
+	if err != nil {
+		c.logger.Error().Err(err).Msg("Failed to get assaults")
+		util.RespondWithError(w, http.StatusInternalServerError, "Failed to get assaults")
+		return
+	}
+
+	// Return success response
+	util.RespondWithJSON(w, http.StatusOK, assaults)
+}
+
+// RebalanceGarrisons handles spreading garrisons across the player's hotspots in their current region
+func (c *TerritoryController) RebalanceGarrisons(w http.ResponseWriter, r *http.Request) {
+	// Get player ID from context
+	playerID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		util.RespondWithError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	// Parse request body (optional, defaults to an even split)
+	var request model.RebalanceGarrisonsRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil && !errors.Is(err, io.EOF) {
+		util.RespondWithError(w, http.StatusBadRequest, "Invalid request format")
+		return
+	}
+
+	// Rebalance the garrisons
+	result, err := c.territoryService.RebalanceGarrisons(playerID, request)
+	if err != nil {
+		c.logger.Error().Err(err).Msg("Failed to rebalance garrisons")
+		util.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Return success response with game message
+	util.RespondWithGameMessage(w, http.StatusOK, result, util.GameMessageTypeSuccess, result.Message)
+}
+
+// GetHotspotFortifications handles getting the fortification tracks of a controlled hotspot
+func (c *TerritoryController) GetHotspotFortifications(w http.ResponseWriter, r *http.Request) {
+	// Get player ID from context
+	playerID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		util.RespondWithError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	// Get hotspot ID from URL
+	hotspotID := chi.URLParam(r, "id")
+	if hotspotID == "" {
+		util.RespondWithError(w, http.StatusBadRequest, "Hotspot ID is required")
+		return
+	}
+
+	// Get the fortifications
+	fortifications, err := c.territoryService.GetHotspotFortifications(playerID, hotspotID)
+	if err != nil {
+		c.logger.Error().Err(err).Str("hotspotID", hotspotID).Msg("Failed to get hotspot fortifications")
+		util.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Return success response
+	util.RespondWithJSON(w, http.StatusOK, fortifications)
+}
+
+// GetHotspotHistory handles getting a hotspot's ownership timeline and recent actions
+func (c *TerritoryController) GetHotspotHistory(w http.ResponseWriter, r *http.Request) {
+	// Get player ID from context
+	playerID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		util.RespondWithError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	// Get hotspot ID from URL
+	hotspotID := chi.URLParam(r, "id")
+	if hotspotID == "" {
+		util.RespondWithError(w, http.StatusBadRequest, "Hotspot ID is required")
+		return
+	}
+
+	// Get the history
+	history, err := c.territoryService.GetHotspotHistory(playerID, hotspotID)
+	if err != nil {
+		c.logger.Error().Err(err).Str("hotspotID", hotspotID).Msg("Failed to get hotspot history")
+		util.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Return success response
+	util.RespondWithJSON(w, http.StatusOK, history)
+}
+
+// PurchaseUpgrade handles buying the next level of a fortification track for a hotspot
+func (c *TerritoryController) PurchaseUpgrade(w http.ResponseWriter, r *http.Request) {
+	// Get player ID from context
+	playerID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		util.RespondWithError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	// Get hotspot ID and track from URL
+	hotspotID := chi.URLParam(r, "id")
+	track := chi.URLParam(r, "track")
+	if hotspotID == "" || track == "" {
+		util.RespondWithError(w, http.StatusBadRequest, "Hotspot ID and track are required")
+		return
+	}
+
+	// Purchase the upgrade
+	result, err := c.territoryService.PurchaseUpgrade(playerID, hotspotID, track)
+	if err != nil {
+		c.logger.Error().Err(err).Str("hotspotID", hotspotID).Str("track", track).Msg("Failed to purchase upgrade")
+		util.RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Return success response with game message
+	util.RespondWithGameMessage(w, http.StatusOK, result, util.GameMessageTypeSuccess, result.Message)
+}
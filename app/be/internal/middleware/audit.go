@@ -0,0 +1,48 @@
+// internal/middleware/audit.go
+
+package middleware
+
+import (
+	"net/http"
+	"time"
+
+	"mwce-be/internal/model"
+	"mwce-be/internal/service"
+
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+// NewAuditMiddleware creates a middleware that records every request in the admin audit log.
+// It must be mounted after Authenticate so the acting user is known.
+func NewAuditMiddleware(adminService service.AdminService) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+			// Process the request
+			defer func() {
+				actorID, _ := GetUserID(r.Context())
+				actorRole, _ := GetUserRole(r.Context())
+
+				status := ww.Status()
+				if status == 0 {
+					status = http.StatusOK
+				}
+
+				// Record the request after it's completed
+				adminService.RecordAuditLog(&model.AdminAuditLog{
+					ActorID:    actorID,
+					ActorRole:  actorRole,
+					Method:     r.Method,
+					Path:       r.URL.String(),
+					StatusCode: status,
+					RemoteAddr: r.RemoteAddr,
+					RequestID:  middleware.GetReqID(r.Context()),
+					Timestamp:  time.Now(),
+				})
+			}()
+
+			next.ServeHTTP(ww, r)
+		})
+	}
+}
@@ -4,6 +4,7 @@ package middleware
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -18,6 +19,8 @@ type Key string
 const (
 	// UserIDKey is the key for user ID in context
 	UserIDKey Key = "userID"
+	// UserRoleKey is the key for the user role in context
+	UserRoleKey Key = "userRole"
 )
 
 // AuthMiddleware handles authentication
@@ -51,21 +54,59 @@ func (am *AuthMiddleware) Authenticate(next http.Handler) http.Handler {
 
 		// Extract and validate the token
 		token := headerParts[1]
-		userID, err := am.authService.ValidateToken(token)
+		claims, err := am.authService.ValidateToken(token)
+		switch {
+		case errors.Is(err, service.ErrAccountBanned):
+			util.RespondWithError(w, http.StatusForbidden, "Account banned")
+			return
+		case errors.Is(err, service.ErrAccountSuspended):
+			util.RespondWithError(w, http.StatusForbidden, "Account suspended")
+			return
+		}
 		if err != nil {
 			fmt.Println(err)
 			util.RespondWithError(w, http.StatusUnauthorized, "Invalid or expired token")
 			return
 		}
 
-		// Add the user ID to the request context
-		ctx := context.WithValue(r.Context(), UserIDKey, userID)
+		// Add the user ID and role to the request context
+		ctx := context.WithValue(r.Context(), UserIDKey, claims.UserID)
+		ctx = context.WithValue(ctx, UserRoleKey, claims.Role)
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
+// RequireRole only lets requests through when the authenticated user has one of the given roles.
+// It must be mounted after Authenticate.
+func (am *AuthMiddleware) RequireRole(roles ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			role, ok := GetUserRole(r.Context())
+			if !ok {
+				util.RespondWithError(w, http.StatusUnauthorized, "Unauthorized")
+				return
+			}
+
+			for _, allowed := range roles {
+				if role == allowed {
+					next.ServeHTTP(w, r)
+					return
+				}
+			}
+
+			util.RespondWithError(w, http.StatusForbidden, "Insufficient permissions")
+		})
+	}
+}
+
 // GetUserID extracts the user ID from the request context
 func GetUserID(ctx context.Context) (string, bool) {
 	userID, ok := ctx.Value(UserIDKey).(string)
 	return userID, ok
 }
+
+// GetUserRole extracts the user role from the request context
+func GetUserRole(ctx context.Context) (string, bool) {
+	role, ok := ctx.Value(UserRoleKey).(string)
+	return role, ok
+}
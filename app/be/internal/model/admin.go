@@ -0,0 +1,128 @@
+// internal/model/admin.go
+
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// AdminAuditLog records a request made against the admin API
+type AdminAuditLog struct {
+	ID         string    `json:"id" gorm:"type:uuid;primary_key"`
+	ActorID    string    `json:"actorId" gorm:"type:uuid;not null;index"`
+	ActorRole  string    `json:"actorRole" gorm:"not null"`
+	Method     string    `json:"method" gorm:"not null"`
+	Path       string    `json:"path" gorm:"not null"`
+	StatusCode int       `json:"statusCode" gorm:"not null"`
+	RemoteAddr string    `json:"remoteAddr"`
+	RequestID  string    `json:"requestId"`
+	Timestamp  time.Time `json:"timestamp" gorm:"not null;index"`
+}
+
+// BeforeCreate is a GORM hook to generate UUID before creating a new audit log entry
+func (a *AdminAuditLog) BeforeCreate(tx *gorm.DB) error {
+	if a.ID == "" {
+		a.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// PlayerSanction records a suspension, ban or lift applied to a player
+type PlayerSanction struct {
+	ID        string     `json:"id" gorm:"type:uuid;primary_key"`
+	PlayerID  string     `json:"playerId" gorm:"type:uuid;not null;index;references:players.id"`
+	ActorID   string     `json:"actorId" gorm:"type:uuid;not null"`
+	Type      string     `json:"type" gorm:"not null"` // suspend, ban, lift
+	Reason    string     `json:"reason" gorm:"not null"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	CreatedAt time.Time  `json:"createdAt" gorm:"not null"`
+}
+
+// BeforeCreate is a GORM hook to generate UUID before creating a new sanction
+func (s *PlayerSanction) BeforeCreate(tx *gorm.DB) error {
+	if s.ID == "" {
+		s.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// ResourceAdjustment records a manual change to a player's resources
+type ResourceAdjustment struct {
+	ID           string    `json:"id" gorm:"type:uuid;primary_key"`
+	PlayerID     string    `json:"playerId" gorm:"type:uuid;not null;index;references:players.id"`
+	ActorID      *string   `json:"actorId,omitempty" gorm:"type:uuid"` // Staff player who made the change
+	OperatorName string    `json:"operatorName,omitempty"`             // Operator who made the change through the CLI
+	Money        int       `json:"money" gorm:"not null;default:0"`
+	Crew         int       `json:"crew" gorm:"not null;default:0"`
+	Weapons      int       `json:"weapons" gorm:"not null;default:0"`
+	Vehicles     int       `json:"vehicles" gorm:"not null;default:0"`
+	Respect      int       `json:"respect" gorm:"not null;default:0"`
+	Influence    int       `json:"influence" gorm:"not null;default:0"`
+	Heat         int       `json:"heat" gorm:"not null;default:0"`
+	Note         string    `json:"note" gorm:"not null"`
+	CreatedAt    time.Time `json:"createdAt" gorm:"not null"`
+}
+
+// BeforeCreate is a GORM hook to generate UUID before creating a new resource adjustment
+func (a *ResourceAdjustment) BeforeCreate(tx *gorm.DB) error {
+	if a.ID == "" {
+		a.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// OperatorCommandLog records a command run through the operator CLI
+type OperatorCommandLog struct {
+	ID        string    `json:"id" gorm:"type:uuid;primary_key"`
+	Operator  string    `json:"operator" gorm:"not null;index"`
+	Command   string    `json:"command" gorm:"not null"`
+	Args      string    `json:"args"`
+	DryRun    bool      `json:"dryRun" gorm:"not null;default:false"`
+	Succeeded bool      `json:"succeeded" gorm:"not null;default:false"`
+	Error     string    `json:"error,omitempty"`
+	Timestamp time.Time `json:"timestamp" gorm:"not null;index"`
+}
+
+// BeforeCreate is a GORM hook to generate UUID before creating a new operator command log entry
+func (l *OperatorCommandLog) BeforeCreate(tx *gorm.DB) error {
+	if l.ID == "" {
+		l.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// AdminPlayerState is the full view of a player for support staff
+type AdminPlayerState struct {
+	Player           *Player                  `json:"player"`
+	Stats            *PlayerStats             `json:"stats"`
+	Hotspots         []Hotspot                `json:"hotspots"`
+	ActiveOperations []OperationAttempt       `json:"activeOperations"`
+	CampaignProgress []PlayerCampaignProgress `json:"campaignProgress"`
+	Sanctions        []PlayerSanction         `json:"sanctions"`
+	Adjustments      []ResourceAdjustment     `json:"adjustments"`
+}
+
+// SanctionRequest represents a request to suspend or ban a player
+type SanctionRequest struct {
+	Reason    string     `json:"reason"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"` // Omit for a permanent ban
+}
+
+// ResourceAdjustmentRequest represents a request to adjust a player's resources
+type ResourceAdjustmentRequest struct {
+	Resources map[string]int `json:"resources"`
+	Note      string         `json:"note"`
+}
+
+// AdminNoteRequest represents an admin request that only carries a note
+type AdminNoteRequest struct {
+	Note string `json:"note"`
+}
+
+// UpdateRoleRequest represents a request to change a player's role
+type UpdateRoleRequest struct {
+	Role string `json:"role"`
+}
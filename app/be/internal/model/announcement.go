@@ -0,0 +1,48 @@
+// internal/model/announcement.go
+
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Announcement is a broadcast message from the game masters
+type Announcement struct {
+	ID             string     `json:"id" gorm:"type:uuid;primary_key"`
+	Title          string     `json:"title" gorm:"not null"`
+	Body           string     `json:"body" gorm:"not null"`
+	Severity       string     `json:"severity" gorm:"not null;default:'info'"` // info, warning, critical
+	Audience       string     `json:"audience" gorm:"not null;default:'all'"`  // all, region, title
+	TargetRegionID *string    `json:"targetRegionId,omitempty" gorm:"type:uuid"`
+	TargetTitle    string     `json:"targetTitle,omitempty"` // Minimum title for title-tier announcements
+	StartsAt       time.Time  `json:"startsAt" gorm:"not null;index"`
+	EndsAt         time.Time  `json:"endsAt" gorm:"not null;index"`
+	CreatedBy      string     `json:"createdBy" gorm:"type:uuid;not null"`
+	DeliveredAt    *time.Time `json:"deliveredAt,omitempty"`
+	CancelledAt    *time.Time `json:"cancelledAt,omitempty"`
+	CreatedAt      time.Time  `json:"createdAt" gorm:"not null"`
+	UpdatedAt      time.Time  `json:"-" gorm:"not null"`
+}
+
+// BeforeCreate is a GORM hook to generate UUID before creating a new announcement
+func (a *Announcement) BeforeCreate(tx *gorm.DB) error {
+	if a.ID == "" {
+		a.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// CreateAnnouncementRequest represents a request to schedule an announcement
+type CreateAnnouncementRequest struct {
+	Title          string     `json:"title"`
+	Body           string     `json:"body"`
+	Severity       string     `json:"severity"`
+	Audience       string     `json:"audience"`
+	TargetRegionID *string    `json:"targetRegionId,omitempty"`
+	TargetTitle    string     `json:"targetTitle,omitempty"`
+	StartsAt       *time.Time `json:"startsAt,omitempty"` // Defaults to now
+	EndsAt         time.Time  `json:"endsAt"`
+}
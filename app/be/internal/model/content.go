@@ -0,0 +1,103 @@
+// internal/model/content.go
+
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+// ContentVersion is a snapshot of a piece of game content taken every time it changes
+type ContentVersion struct {
+	ID         string    `json:"id" gorm:"type:uuid;primary_key"`
+	EntityType string    `json:"entityType" gorm:"not null;index:idx_content_versions_entity;uniqueIndex:idx_content_versions_entity_version"` // region, district, city, hotspot, operation_template, campaign, chapter
+	EntityID   string    `json:"entityId" gorm:"not null;index:idx_content_versions_entity;uniqueIndex:idx_content_versions_entity_version"`
+	Version    int       `json:"version" gorm:"not null;uniqueIndex:idx_content_versions_entity_version"`
+	Action     string    `json:"action" gorm:"not null"`              // create, update, retire
+	Snapshot   string    `json:"snapshot" gorm:"type:jsonb;not null"` // JSON encoded entity after the change
+	ActorID    string    `json:"actorId" gorm:"type:uuid;not null"`
+	CreatedAt  time.Time `json:"createdAt" gorm:"not null"`
+}
+
+// BeforeCreate is a GORM hook to generate UUID before creating a new content version
+func (v *ContentVersion) BeforeCreate(tx *gorm.DB) error {
+	if v.ID == "" {
+		v.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// OperationTemplate is an admin-managed operation template that overrides or extends the YAML pool
+type OperationTemplate struct {
+	ID                   string                `json:"id" gorm:"primary_key"` // Matches the template ID in operations.yaml when overriding
+	Name                 string                `json:"name" gorm:"not null"`
+	Description          string                `json:"description" gorm:"not null"`
+	Type                 string                `json:"type" gorm:"not null"`
+	IsSpecial            bool                  `json:"isSpecial" gorm:"not null;default:false"`
+	IsRetired            bool                  `json:"isRetired" gorm:"not null;default:false"`
+	Regions              pq.StringArray        `json:"regions" gorm:"type:text[]"` // Region names, as in operations.yaml
+	Requirements         OperationRequirements `json:"requirements" gorm:"embedded"`
+	Resources            OperationResources    `json:"resources" gorm:"embedded"`
+	Rewards              OperationRewards      `json:"rewards" gorm:"embedded"`
+	Risks                OperationRisks        `json:"risks" gorm:"embedded"`
+	Duration             int                   `json:"duration" gorm:"not null"`              // in seconds
+	AvailabilityDuration int                   `json:"availabilityDuration" gorm:"default:0"` // in minutes
+	SuccessRate          int                   `json:"successRate" gorm:"not null"`
+	Source               string                `json:"source" gorm:"-"` // yaml or admin, calculated on retrieval
+	CreatedAt            time.Time             `json:"-" gorm:"not null"`
+	UpdatedAt            time.Time             `json:"-" gorm:"not null"`
+}
+
+// BeforeCreate is a GORM hook to generate UUID before creating a new operation template
+func (t *OperationTemplate) BeforeCreate(tx *gorm.DB) error {
+	if t.ID == "" {
+		t.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// RegionContentRequest represents a request to create or update a region
+type RegionContentRequest struct {
+	Name   string                    `json:"name"`
+	Routes *[]RegionRouteContentData `json:"routes,omitempty"` // Replaces the region's roads when set
+}
+
+// RegionRouteContentData is a road from a region to one of its neighbors
+type RegionRouteContentData struct {
+	RegionID string `json:"regionId"`
+	Distance int    `json:"distance"`
+}
+
+// DistrictContentRequest represents a request to create or update a district
+type DistrictContentRequest struct {
+	Name     string `json:"name"`
+	RegionID string `json:"regionId"`
+}
+
+// CityContentRequest represents a request to create or update a city
+type CityContentRequest struct {
+	Name       string `json:"name"`
+	DistrictID string `json:"districtId"`
+}
+
+// HotspotContentRequest represents a request to create or update a hotspot.
+// Ownership, garrison and income state are never touched by content edits.
+type HotspotContentRequest struct {
+	Name         string `json:"name"`
+	CityID       string `json:"cityId"`
+	Type         string `json:"type"`
+	BusinessType string `json:"businessType"`
+	IsLegal      *bool  `json:"isLegal,omitempty"`
+	Income       *int   `json:"income,omitempty"`
+}
+
+// CampaignContentRequest represents a request to update campaign metadata
+type CampaignContentRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	ImageURL    string `json:"imageUrl"`
+	IsActive    *bool  `json:"isActive,omitempty"`
+}
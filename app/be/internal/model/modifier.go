@@ -0,0 +1,44 @@
+// internal/model/modifier.go
+
+package model
+
+// SuccessModifier is a single adjustment applied to a success chance
+type SuccessModifier struct {
+	Source string `json:"source"` // strength, heat, ...
+	Label  string `json:"label"`
+	Value  int    `json:"value"` // Percentage points, negative for penalties
+}
+
+// SuccessBreakdown itemizes how a success chance was reached
+type SuccessBreakdown struct {
+	BaseChance  int               `json:"baseChance"`
+	Modifiers   []SuccessModifier `json:"modifiers"`
+	MinChance   int               `json:"minChance"`
+	MaxChance   int               `json:"maxChance"`
+	FinalChance int               `json:"finalChance"`
+}
+
+// Add appends a modifier to the breakdown, skipping ones that change nothing
+func (b *SuccessBreakdown) Add(modifier *SuccessModifier) {
+	if modifier == nil || modifier.Value == 0 {
+		return
+	}
+	b.Modifiers = append(b.Modifiers, *modifier)
+}
+
+// Resolve sums the base chance and every modifier, caps the result and stores it as the final chance
+func (b *SuccessBreakdown) Resolve() int {
+	chance := b.BaseChance
+	for _, modifier := range b.Modifiers {
+		chance += modifier.Value
+	}
+
+	if chance < b.MinChance {
+		chance = b.MinChance
+	} else if chance > b.MaxChance {
+		chance = b.MaxChance
+	}
+
+	b.FinalChance = chance
+	return chance
+}
@@ -49,6 +49,7 @@ type OperationRequirements struct {
 	MaxHeat              int    `json:"maxHeat,omitempty" gorm:"default:0"`
 	MinTitle             string `json:"minTitle,omitempty" gorm:"default:''"`
 	RequiredHotspotTypes string `json:"requiredHotspotTypes,omitempty" gorm:"default:''"` // Comma-separated list
+	RequiredBonus        string `json:"requiredBonus,omitempty" gorm:"default:''"`        // Neighborhood bonus tier that unlocks the operation
 }
 
 // OperationResources represents resources required for an operation
@@ -120,7 +121,10 @@ type OperationResult struct {
 	HeatGenerated    int    `json:"heatGenerated,omitempty" gorm:"default:0"`
 	HeatReduced      int    `json:"heatReduced,omitempty" gorm:"default:0"`
 	RewardsCollected bool   `json:"rewardsCollected" gorm:"default:false"`
+	PityBonus        int    `json:"pityBonus,omitempty" gorm:"default:0"` // Success bonus from the failure streak going in
 	Message          string `json:"message" gorm:"not null"`
+
+	Breakdown *SuccessBreakdown `json:"breakdown,omitempty" gorm:"-"` // How the success chance was reached
 }
 
 // StartOperationRequest represents a request to start an operation
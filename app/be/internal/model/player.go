@@ -16,6 +16,10 @@ type Player struct {
 	Email              string     `json:"email" gorm:"unique;not null"`
 	Password           string     `json:"-" gorm:"not null"` // Hashed password, not returned in JSON
 	Title              string     `json:"title" gorm:"not null"`
+	Role               string     `json:"role" gorm:"not null;default:'player'"`          // player, moderator, game_master, admin
+	AccountStatus      string     `json:"accountStatus" gorm:"not null;default:'active'"` // active, suspended, banned
+	StatusReason       string     `json:"statusReason,omitempty"`
+	StatusExpiresAt    *time.Time `json:"statusExpiresAt,omitempty"`
 	Money              int        `json:"money" gorm:"not null;default:0"`
 	Crew               int        `json:"crew" gorm:"not null;default:0"`
 	MaxCrew            int        `json:"maxCrew" gorm:"not null;default:25"`
@@ -26,8 +30,15 @@ type Player struct {
 	Respect            int        `json:"respect" gorm:"not null;default:0"`
 	Influence          int        `json:"influence" gorm:"not null;default:0"`
 	Heat               int        `json:"heat" gorm:"not null;default:0"`
+	HeatWarningLevel   int        `json:"heatWarningLevel" gorm:"not null;default:0"` // Number of warning thresholds currently crossed
+	HeatUpdatedAt      *time.Time `json:"-"`                                          // Last time decay was applied
+	LastHeatSample     int        `json:"-" gorm:"not null;default:0"`                // Heat at the last history sample
 	CurrentRegionID    *string    `json:"currentRegionId" gorm:"type:uuid;references:regions.id"`
 	LastTravelTime     *time.Time `json:"lastTravelTime"`
+	InTransitTo        *string    `json:"inTransitTo,omitempty" gorm:"type:uuid;references:regions.id"` // Destination while travelling
+	ArrivalTime        *time.Time `json:"arrivalTime,omitempty" gorm:"index"`
+	JailedUntil        *time.Time `json:"jailedUntil,omitempty"`
+	ProtectedUntil     *time.Time `json:"protectedUntil,omitempty"` // Shield after a major loss, hotspots can't be taken over
 	CreatedAt          time.Time  `json:"createdAt" gorm:"not null"`
 	LastActive         time.Time  `json:"lastActive" gorm:"not null"`
 	TotalHotspots      int        `json:"totalHotspotCount" gorm:"-"`  // Calculated field, not stored in DB
@@ -41,6 +52,9 @@ type Player struct {
 	RegionalTotalHotspots int    `json:"regionalTotalHotspots" gorm:"-"` // Total hotspots in current region
 	RegionalRevenue       int    `json:"regionalRevenue" gorm:"-"`       // Revenue from current region
 	RegionalPending       int    `json:"regionalPending" gorm:"-"`       // Pending collections in current region
+
+	NeighborhoodBonuses []NeighborhoodBonus `json:"neighborhoodBonuses,omitempty" gorm:"-"` // Active neighborhood control bonuses
+	PityBonuses         map[string]int      `json:"pityBonuses,omitempty" gorm:"-"`         // Success bonus earned by failure streaks, by category
 }
 
 // BeforeCreate is a GORM hook to generate UUID before creating a new player
@@ -61,6 +75,8 @@ type PlayerStats struct {
 	MaxRespectAchieved       int       `json:"maxRespectAchieved" gorm:"not null;default:0"`
 	SuccessfulTakeovers      int       `json:"successfulTakeovers" gorm:"not null;default:0"`
 	FailedTakeovers          int       `json:"failedTakeovers" gorm:"not null;default:0"`
+	DefensesWon              int       `json:"defensesWon" gorm:"not null;default:0"`
+	DefensesLost             int       `json:"defensesLost" gorm:"not null;default:0"`
 	RegionsVisited           int       `json:"regionsVisited" gorm:"not null;default:0"`
 	TotalTravelDistance      int       `json:"totalTravelDistance" gorm:"not null;default:0"` // Could be used for achievements
 	CreatedAt                time.Time `json:"-" gorm:"not null"`
@@ -139,15 +155,73 @@ type TravelRequest struct {
 
 // TravelResponse represents the response after attempting to travel
 type TravelResponse struct {
-	Success        bool   `json:"success"`
-	RegionID       string `json:"regionId"`
-	RegionName     string `json:"regionName"`
-	TravelCost     int    `json:"travelCost"`
-	HeatReduction  int    `json:"heatReduction,omitempty"`
-	Message        string `json:"message"`
-	CaughtByPolice bool   `json:"caughtByPolice,omitempty"`
-	FineAmount     int    `json:"fineAmount,omitempty"`
-	HeatIncrease   int    `json:"heatIncrease,omitempty"`
+	Success        bool        `json:"success"`
+	RegionID       string      `json:"regionId"`
+	RegionName     string      `json:"regionName"`
+	TravelCost     int         `json:"travelCost"`
+	HeatReduction  int         `json:"heatReduction,omitempty"`
+	Message        string      `json:"message"`
+	CaughtByPolice bool        `json:"caughtByPolice,omitempty"`
+	FineAmount     int         `json:"fineAmount,omitempty"`
+	HeatIncrease   int         `json:"heatIncrease,omitempty"`
+	Route          []RouteStop `json:"route,omitempty"`
+	InTransit      bool        `json:"inTransit,omitempty"`
+	ArrivalTime    *time.Time  `json:"arrivalTime,omitempty"`
+	Arrested       bool        `json:"arrested,omitempty"`
+	JailedUntil    *time.Time  `json:"jailedUntil,omitempty"`
+}
+
+// RouteStop is a region passed through on the way to a travel destination
+type RouteStop struct {
+	RegionID    string  `json:"regionId"`
+	RegionName  string  `json:"regionName"`
+	Distance    int     `json:"distance"`    // From the previous stop
+	CatchChance float64 `json:"catchChance"` // Chance of being stopped at this region's checkpoint
+}
+
+// TravelDestination is a region the player can travel to, along with what the trip costs
+type TravelDestination struct {
+	Region
+	Current     bool        `json:"current,omitempty"`
+	TravelCost  int         `json:"travelCost"`
+	CatchChance float64     `json:"catchChance"`
+	Hops        int         `json:"hops"`
+	Distance    int         `json:"distance"`
+	Route       []RouteStop `json:"route"`
+}
+
+// HeatHistory is a sample of a player's heat over time
+type HeatHistory struct {
+	ID        string    `json:"id" gorm:"type:uuid;primary_key"`
+	PlayerID  string    `json:"-" gorm:"type:uuid;not null;index;references:players.id"`
+	Heat      int       `json:"heat" gorm:"not null"`
+	Timestamp time.Time `json:"timestamp" gorm:"not null;index"`
+}
+
+// BeforeCreate is a GORM hook to generate UUID before creating a new heat history sample
+func (h *HeatHistory) BeforeCreate(tx *gorm.DB) error {
+	if h.ID == "" {
+		h.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// FailureStreak counts a player's consecutive failures in one category of action
+type FailureStreak struct {
+	PlayerID  string    `json:"-" gorm:"type:uuid;primary_key;references:players.id"`
+	Category  string    `json:"category" gorm:"primary_key"` // operations, extortion, takeover
+	Count     int       `json:"count" gorm:"not null;default:0"`
+	UpdatedAt time.Time `json:"-" gorm:"not null"`
+}
+
+// HeatHistoryResponse represents a player's current heat and its recent history
+type HeatHistoryResponse struct {
+	Heat              int           `json:"heat"`
+	MaxHeat           int           `json:"maxHeat"`
+	DecayRate         int           `json:"decayRate"`
+	WarningLevel      int           `json:"warningLevel"`
+	WarningThresholds []int         `json:"warningThresholds"`
+	History           []HeatHistory `json:"history"`
 }
 
 // TravelAttempt represents a travel attempt by a player
@@ -172,3 +246,34 @@ func (t *TravelAttempt) BeforeCreate(tx *gorm.DB) error {
 	}
 	return nil
 }
+
+// Trip is a journey in progress. The route, risk and cost are fixed when the player sets off,
+// so the arrival rolls the checkpoints the player was quoted rather than whatever the map looks like by then.
+type Trip struct {
+	PlayerID     string      `json:"playerId" gorm:"type:uuid;primary_key"`
+	FromRegionID *string     `json:"fromRegionId" gorm:"type:uuid"`
+	ToRegionID   string      `json:"toRegionId" gorm:"type:uuid;not null"`
+	ToRegionName string      `json:"toRegionName" gorm:"not null"`
+	Cost         int         `json:"cost" gorm:"not null"`
+	Stops        []RouteStop `json:"stops" gorm:"type:jsonb;serializer:json"`
+	DepartedAt   time.Time   `json:"departedAt" gorm:"not null"`
+}
+
+// JailStatus shows whether a player is in jail and what getting out early costs
+type JailStatus struct {
+	Jailed           bool       `json:"jailed"`
+	JailedUntil      *time.Time `json:"jailedUntil,omitempty"`
+	RemainingSeconds int        `json:"remainingSeconds"`
+	BailCost         int        `json:"bailCost,omitempty"`
+	BribeCost        int        `json:"bribeCost,omitempty"`
+	BribeChance      float64    `json:"bribeChance,omitempty"`
+}
+
+// JailActionResponse represents the result of paying bail or bribing a judge
+type JailActionResponse struct {
+	Released     bool       `json:"released"`
+	Cost         int        `json:"cost"`
+	HeatIncrease int        `json:"heatIncrease,omitempty"`
+	JailedUntil  *time.Time `json:"jailedUntil,omitempty"`
+	Message      string     `json:"message"`
+}
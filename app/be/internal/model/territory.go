@@ -11,11 +11,14 @@ import (
 
 // Region represents a region on the map
 type Region struct {
-	ID        string     `json:"id" gorm:"type:uuid;primary_key"`
-	Name      string     `json:"name" gorm:"not null"`
-	Districts []District `json:"districts,omitempty" gorm:"foreignKey:RegionID"`
-	CreatedAt time.Time  `json:"-" gorm:"not null"`
-	UpdatedAt time.Time  `json:"-" gorm:"not null"`
+	ID        string         `json:"id" gorm:"type:uuid;primary_key"`
+	Name      string         `json:"name" gorm:"not null"`
+	Heat      int            `json:"heat" gorm:"not null;default:0"` // Local police attention
+	Districts []District     `json:"districts,omitempty" gorm:"foreignKey:RegionID"`
+	Routes    []RegionRoute  `json:"routes,omitempty" gorm:"-"` // Set by content changes that touch the region's roads
+	CreatedAt time.Time      `json:"-" gorm:"not null"`
+	UpdatedAt time.Time      `json:"-" gorm:"not null"`
+	RetiredAt gorm.DeletedAt `json:"-" gorm:"index"` // Retired content is hidden but kept for history
 }
 
 // BeforeCreate is a GORM hook to generate UUID before creating a new region
@@ -26,14 +29,32 @@ func (r *Region) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
+// RegionRoute is a road between two neighboring regions, usable in both directions
+type RegionRoute struct {
+	ID           string    `json:"id" gorm:"type:uuid;primary_key"`
+	FromRegionID string    `json:"fromRegionId" gorm:"type:uuid;not null;uniqueIndex:idx_region_route"`
+	ToRegionID   string    `json:"toRegionId" gorm:"type:uuid;not null;uniqueIndex:idx_region_route;index"`
+	Distance     int       `json:"distance" gorm:"not null;default:1"`
+	CreatedAt    time.Time `json:"-" gorm:"not null"`
+}
+
+// BeforeCreate is a GORM hook to generate UUID before creating a new region route
+func (r *RegionRoute) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == "" {
+		r.ID = uuid.New().String()
+	}
+	return nil
+}
+
 // District represents a district within a region
 type District struct {
-	ID        string    `json:"id" gorm:"type:uuid;primary_key"`
-	Name      string    `json:"name" gorm:"not null"`
-	RegionID  string    `json:"regionId" gorm:"type:uuid;not null;references:regions.id"`
-	Cities    []City    `json:"cities,omitempty" gorm:"foreignKey:DistrictID"`
-	CreatedAt time.Time `json:"-" gorm:"not null"`
-	UpdatedAt time.Time `json:"-" gorm:"not null"`
+	ID        string         `json:"id" gorm:"type:uuid;primary_key"`
+	Name      string         `json:"name" gorm:"not null"`
+	RegionID  string         `json:"regionId" gorm:"type:uuid;not null;references:regions.id"`
+	Cities    []City         `json:"cities,omitempty" gorm:"foreignKey:DistrictID"`
+	CreatedAt time.Time      `json:"-" gorm:"not null"`
+	UpdatedAt time.Time      `json:"-" gorm:"not null"`
+	RetiredAt gorm.DeletedAt `json:"-" gorm:"index"` // Retired content is hidden but kept for history
 }
 
 // BeforeCreate is a GORM hook to generate UUID before creating a new district
@@ -46,12 +67,17 @@ func (d *District) BeforeCreate(tx *gorm.DB) error {
 
 // City represents a city within a district
 type City struct {
-	ID         string    `json:"id" gorm:"type:uuid;primary_key"`
-	Name       string    `json:"name" gorm:"not null"`
-	DistrictID string    `json:"districtId" gorm:"type:uuid;not null;references:districts.id"`
-	Hotspots   []Hotspot `json:"hotspots,omitempty" gorm:"foreignKey:CityID"`
-	CreatedAt  time.Time `json:"-" gorm:"not null"`
-	UpdatedAt  time.Time `json:"-" gorm:"not null"`
+	ID                  string              `json:"id" gorm:"type:uuid;primary_key"`
+	Name                string              `json:"name" gorm:"not null"`
+	DistrictID          string              `json:"districtId" gorm:"type:uuid;not null;references:districts.id"`
+	Heat                int                 `json:"heat" gorm:"not null;default:0"` // Local police attention
+	CrackdownUntil      *time.Time          `json:"crackdownUntil,omitempty"`       // Illegal businesses are frozen until then
+	LastIllegalRotation *time.Time          `json:"-"`                              // When the city's illegal businesses were last rotated
+	Hotspots            []Hotspot           `json:"hotspots,omitempty" gorm:"foreignKey:CityID"`
+	Bonuses             []NeighborhoodBonus `json:"bonuses,omitempty" gorm:"-"` // Neighborhood bonuses active in this city
+	CreatedAt           time.Time           `json:"-" gorm:"not null"`
+	UpdatedAt           time.Time           `json:"-" gorm:"not null"`
+	RetiredAt           gorm.DeletedAt      `json:"-" gorm:"index"` // Retired content is hidden but kept for history
 }
 
 // BeforeCreate is a GORM hook to generate UUID before creating a new city
@@ -71,7 +97,8 @@ type Hotspot struct {
 	IsLegal            bool                   `json:"isLegal" gorm:"not null"`
 	ControllerID       *string                `json:"controller,omitempty" gorm:"type:uuid;references:players.id"`
 	ControllerName     *string                `json:"controllerName,omitempty" gorm:"-"`
-	Income             int                    `json:"income" gorm:"not null;default:0"` // Income per hour
+	ProtectedUntil     *time.Time             `json:"protectedUntil,omitempty" gorm:"-"` // The controller's loss shield, if active
+	Income             int                    `json:"income" gorm:"not null;default:0"`  // Income per hour
 	PendingCollection  int                    `json:"pendingCollection" gorm:"not null;default:0"`
 	LastCollectionTime *time.Time             `json:"lastCollectionTime"`
 	LastIncomeTime     *time.Time             `json:"lastIncomeTime"` // Time of last income generation
@@ -81,7 +108,11 @@ type Hotspot struct {
 	DefenseStrength    int                    `json:"defenseStrength" gorm:"not null;default:0"` // Calculated from resources
 	CreatedAt          time.Time              `json:"-" gorm:"not null"`
 	UpdatedAt          time.Time              `json:"-" gorm:"not null"`
+	RetiredAt          gorm.DeletedAt         `json:"-" gorm:"index"` // Retired content is hidden but kept for history
 	Metadata           map[string]interface{} `json:"metadata,omitempty" gorm:"-"`
+	IncomeBreakdown    *IncomeBreakdown       `json:"incomeBreakdown,omitempty" gorm:"-"` // How the hourly income was reached
+	PendingAssaults    []Assault              `json:"pendingAssaults,omitempty" gorm:"-"` // Spotted assaults still being prepared
+	ClosedUntil        *time.Time             `json:"closedUntil,omitempty"`              // Shut down by a police raid until then
 }
 
 // BeforeCreate is a GORM hook to generate UUID before creating a new hotspot
@@ -94,14 +125,16 @@ func (h *Hotspot) BeforeCreate(tx *gorm.DB) error {
 
 // TerritoryAction represents an action taken on a territory
 type TerritoryAction struct {
-	ID        string          `json:"id" gorm:"type:uuid;primary_key"`
-	Type      string          `json:"type" gorm:"not null"` // extortion, takeover, collection, defend
-	PlayerID  string          `json:"playerId" gorm:"type:uuid;not null;references:players.id"`
-	HotspotID string          `json:"hotspotId" gorm:"type:uuid;not null;references:hotspots.id"`
-	Resources ActionResources `json:"resources" gorm:"embedded"`
-	Result    *ActionResult   `json:"result" gorm:"embedded"`
-	Timestamp time.Time       `json:"timestamp" gorm:"not null"`
-	CreatedAt time.Time       `json:"-" gorm:"not null"`
+	ID         string          `json:"id" gorm:"type:uuid;primary_key"`
+	Type       string          `json:"type" gorm:"not null"` // extortion, takeover, collection, defend, withdraw, transfer, takeover_defense
+	PlayerID   string          `json:"playerId" gorm:"type:uuid;not null;references:players.id"`
+	HotspotID  string          `json:"hotspotId" gorm:"type:uuid;not null;references:hotspots.id"`
+	OpponentID *string         `json:"opponentId,omitempty" gorm:"type:uuid"`      // The other side of a contested takeover
+	TargetID   *string         `json:"targetHotspotId,omitempty" gorm:"type:uuid"` // Destination of a garrison transfer
+	Resources  ActionResources `json:"resources" gorm:"embedded"`
+	Result     *ActionResult   `json:"result" gorm:"embedded"`
+	Timestamp  time.Time       `json:"timestamp" gorm:"not null"`
+	CreatedAt  time.Time       `json:"-" gorm:"not null"`
 }
 
 // BeforeCreate is a GORM hook to generate UUID before creating a new territory action
@@ -135,13 +168,36 @@ type ActionResult struct {
 	InfluenceGained int    `json:"influenceGained,omitempty" gorm:"default:0"`
 	InfluenceLost   int    `json:"influenceLost,omitempty" gorm:"default:0"`
 	HeatGenerated   int    `json:"heatGenerated,omitempty" gorm:"default:0"`
+	SuccessChance   int    `json:"successChance" gorm:"default:0"`
 	Message         string `json:"message" gorm:"not null"`
+
+	Breakdown *SuccessBreakdown   `json:"breakdown,omitempty" gorm:"-"` // How the success chance was reached
+	Garrison  *GarrisonResolution `json:"garrison,omitempty" gorm:"-"`  // What happened to a defeated garrison
+	Underdog  *UnderdogMatchup    `json:"underdog,omitempty" gorm:"-"`  // How the two sides of a contested takeover compared
+}
+
+// UnderdogMatchup compares the standing of a takeover attacker and defender
+type UnderdogMatchup struct {
+	AttackerPower    int     `json:"attackerPower"`
+	DefenderPower    int     `json:"defenderPower"`
+	Ratio            float64 `json:"ratio"` // Defender power over attacker power
+	Label            string  `json:"label,omitempty"`
+	ChanceBonus      int     `json:"chanceBonus"`
+	RewardMultiplier float64 `json:"rewardMultiplier"`
+}
+
+// GarrisonResolution splits a defeated garrison between losses, the attacker and the defender
+type GarrisonResolution struct {
+	Destroyed ActionResources `json:"destroyed"`
+	Captured  ActionResources `json:"captured"` // Taken by the attacker
+	Fled      ActionResources `json:"fled"`     // Returned to the defender
 }
 
 // PerformActionRequest represents a request to perform a territory action
 type PerformActionRequest struct {
-	HotspotID string          `json:"hotspotId"`
-	Resources ActionResources `json:"resources"`
+	HotspotID       string          `json:"hotspotId"`
+	TargetHotspotID string          `json:"targetHotspotId,omitempty"` // Only used by transfers
+	Resources       ActionResources `json:"resources"`
 }
 
 // CollectResponse represents the response after collecting income from a hotspot
@@ -151,3 +207,237 @@ type CollectResponse struct {
 	CollectedAmount int    `json:"collectedAmount"`
 	Message         string `json:"message"`
 }
+
+// IllegalRotationResult summarises a rotation of the illegal businesses
+type IllegalRotationResult struct {
+	Retired   int       `json:"retired"`
+	Spawned   int       `json:"spawned"`
+	Cities    int       `json:"cities"`
+	RotatedAt time.Time `json:"rotatedAt"`
+}
+
+// NeighborhoodBonus is a bonus a player holds for controlling many hotspots in a city, district or region
+type NeighborhoodBonus struct {
+	TierID           string  `json:"tierId"`
+	Name             string  `json:"name"`
+	Scope            string  `json:"scope"` // city, district, region
+	AreaID           string  `json:"areaId"`
+	AreaName         string  `json:"areaName"`
+	PlayerID         string  `json:"playerId"`
+	PlayerName       string  `json:"playerName,omitempty"`
+	Controlled       int     `json:"controlled"`
+	Total            int     `json:"total"`
+	IncomeMultiplier float64 `json:"incomeMultiplier"`
+	DefenseBonus     int     `json:"defenseBonus,omitempty"` // Percentage added to hotspot defense
+	InfluencePerHour int     `json:"influencePerHour,omitempty"`
+}
+
+// HotspotControlCount counts the legal hotspots a controller holds in a city
+type HotspotControlCount struct {
+	CityID         string
+	CityName       string
+	DistrictID     string
+	DistrictName   string
+	RegionID       string
+	RegionName     string
+	ControllerID   *string
+	ControllerName *string
+	Hotspots       int
+}
+
+// GarrisonTransfer is a garrison moving between two hotspots in different regions
+type GarrisonTransfer struct {
+	ID            string          `json:"id" gorm:"type:uuid;primary_key"`
+	PlayerID      string          `json:"playerId" gorm:"type:uuid;not null;index;references:players.id"`
+	FromHotspotID string          `json:"fromHotspotId" gorm:"type:uuid;not null;references:hotspots.id"`
+	ToHotspotID   string          `json:"toHotspotId" gorm:"type:uuid;not null;references:hotspots.id"`
+	Resources     ActionResources `json:"resources" gorm:"embedded"`
+	Status        string          `json:"status" gorm:"not null;index"` // in_transit, arrived, returned
+	DepartedAt    time.Time       `json:"departedAt" gorm:"not null"`
+	ArrivesAt     time.Time       `json:"arrivesAt" gorm:"not null;index"`
+	CompletedAt   *time.Time      `json:"completedAt,omitempty"`
+}
+
+// BeforeCreate is a GORM hook to generate UUID before creating a new garrison transfer
+func (g *GarrisonTransfer) BeforeCreate(tx *gorm.DB) error {
+	if g.ID == "" {
+		g.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// HotspotOwnership is one period during which a player controlled a hotspot
+type HotspotOwnership struct {
+	ID             string     `json:"id" gorm:"type:uuid;primary_key"`
+	HotspotID      string     `json:"hotspotId" gorm:"type:uuid;not null;index;references:hotspots.id"`
+	ControllerID   string     `json:"controllerId" gorm:"type:uuid;not null;index"`
+	ControllerName string     `json:"controllerName" gorm:"-"`
+	AcquiredAt     time.Time  `json:"acquiredAt" gorm:"not null"`
+	AcquiredHow    string     `json:"acquiredHow" gorm:"not null"` // takeover, admin_transfer
+	LostAt         *time.Time `json:"lostAt,omitempty" gorm:"index"`
+	LostHow        string     `json:"lostHow,omitempty"` // takeover, admin_release, admin_transfer
+	LostToID       *string    `json:"lostToId,omitempty" gorm:"type:uuid"`
+	LostToName     string     `json:"lostToName,omitempty" gorm:"-"`
+}
+
+// BeforeCreate is a GORM hook to generate UUID before creating a new ownership period
+func (o *HotspotOwnership) BeforeCreate(tx *gorm.DB) error {
+	if o.ID == "" {
+		o.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// HotspotHistoryEntry is an action taken against a hotspot, redacted for players who don't control it
+type HotspotHistoryEntry struct {
+	ID         string           `json:"id"`
+	Type       string           `json:"type"`
+	PlayerID   string           `json:"playerId,omitempty"`
+	PlayerName string           `json:"playerName,omitempty"`
+	Resources  *ActionResources `json:"resources,omitempty"`
+	Success    bool             `json:"success"`
+	Message    string           `json:"message,omitempty"`
+	Timestamp  time.Time        `json:"timestamp"`
+}
+
+// HotspotHistoryResponse is a hotspot's ownership timeline and the recent actions taken against it
+type HotspotHistoryResponse struct {
+	HotspotID   string                `json:"hotspotId"`
+	HotspotName string                `json:"hotspotName"`
+	Redacted    bool                  `json:"redacted"` // Set for players who don't control the hotspot
+	Ownership   []HotspotOwnership    `json:"ownership"`
+	Actions     []HotspotHistoryEntry `json:"actions"`
+}
+
+// Assault is a staged takeover that resolves once the attacker's crew has finished preparing
+type Assault struct {
+	ID            string          `json:"id" gorm:"type:uuid;primary_key"`
+	AttackerID    string          `json:"attackerId" gorm:"type:uuid;not null;index;references:players.id"`
+	AttackerName  string          `json:"attackerName" gorm:"-"`
+	HotspotID     string          `json:"hotspotId" gorm:"type:uuid;not null;index;references:hotspots.id"`
+	HotspotName   string          `json:"hotspotName" gorm:"-"`
+	DefenderID    string          `json:"defenderId" gorm:"type:uuid;not null;index"` // Controller when the assault was launched
+	Resources     ActionResources `json:"resources" gorm:"embedded"`
+	Status        string          `json:"status" gorm:"not null;index"` // preparing, resolving, succeeded, failed, called_off
+	LaunchedAt    time.Time       `json:"launchedAt" gorm:"not null"`
+	WarnsAt       time.Time       `json:"-" gorm:"not null"`
+	WarnedAt      *time.Time      `json:"warnedAt,omitempty"`
+	ResolvesAt    time.Time       `json:"resolvesAt" gorm:"not null;index"`
+	ResolvedAt    *time.Time      `json:"resolvedAt,omitempty"`
+	ResultMessage string          `json:"resultMessage,omitempty"`
+}
+
+// BeforeCreate is a GORM hook to generate UUID before creating a new assault
+func (a *Assault) BeforeCreate(tx *gorm.DB) error {
+	if a.ID == "" {
+		a.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// AssaultsResponse lists the assaults a player is preparing and the ones threatening them
+type AssaultsResponse struct {
+	Outgoing []Assault `json:"outgoing"`
+	Incoming []Assault `json:"incoming"` // Only assaults the player has spotted
+}
+
+// RebalanceGarrisonsRequest represents a request to rebalance garrisons in the current region
+type RebalanceGarrisonsRequest struct {
+	Strategy string `json:"strategy"` // even (default) or income
+}
+
+// RebalanceGarrisonsResponse represents the garrisons after a rebalance
+type RebalanceGarrisonsResponse struct {
+	Hotspots []Hotspot `json:"hotspots"`
+	Message  string    `json:"message"`
+}
+
+// HotspotUpgrade is the level a hotspot has reached on one fortification track
+type HotspotUpgrade struct {
+	ID          string     `json:"id" gorm:"type:uuid;primary_key"`
+	HotspotID   string     `json:"hotspotId" gorm:"type:uuid;not null;uniqueIndex:idx_hotspot_upgrade_track;references:hotspots.id"`
+	Track       string     `json:"track" gorm:"not null;uniqueIndex:idx_hotspot_upgrade_track"`
+	Level       int        `json:"level" gorm:"not null;default:0"`
+	BuildingTo  int        `json:"buildingTo,omitempty" gorm:"not null;default:0"` // Level under construction, 0 when idle
+	CompletesAt *time.Time `json:"completesAt,omitempty" gorm:"index"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+}
+
+// BeforeCreate is a GORM hook to generate UUID before creating a new hotspot upgrade
+func (u *HotspotUpgrade) BeforeCreate(tx *gorm.DB) error {
+	if u.ID == "" {
+		u.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// FortificationEffects are the combined effects of a hotspot's fortification levels
+type FortificationEffects struct {
+	DefenseFlat            int `json:"defenseFlat,omitempty"`
+	DefensePercent         int `json:"defensePercent,omitempty"`
+	CollectionBonus        int `json:"collectionBonus,omitempty"`
+	WarningWindowReduction int `json:"warningWindowReduction,omitempty"`
+}
+
+// FortificationLevelInfo describes a level that can be bought on a track
+type FortificationLevelInfo struct {
+	Level     int                  `json:"level"`
+	Cost      int                  `json:"cost"`
+	BuildTime int                  `json:"buildTime"` // in seconds
+	Effects   FortificationEffects `json:"effects"`
+}
+
+// FortificationStatus shows a hotspot's progress on one fortification track
+type FortificationStatus struct {
+	Track       string                  `json:"track"`
+	Name        string                  `json:"name"`
+	Description string                  `json:"description"`
+	Level       int                     `json:"level"`
+	MaxLevel    int                     `json:"maxLevel"`
+	Effects     FortificationEffects    `json:"effects"`
+	NextLevel   *FortificationLevelInfo `json:"nextLevel,omitempty"`
+	BuildingTo  int                     `json:"buildingTo,omitempty"`
+	CompletesAt *time.Time              `json:"completesAt,omitempty"`
+}
+
+// HotspotFortificationsResponse lists every fortification track for a hotspot
+type HotspotFortificationsResponse struct {
+	HotspotID string                `json:"hotspotId"`
+	Effects   FortificationEffects  `json:"effects"`
+	Tracks    []FortificationStatus `json:"tracks"`
+}
+
+// PurchaseUpgradeResponse represents the result of buying a fortification level
+type PurchaseUpgradeResponse struct {
+	Track   FortificationStatus `json:"track"`
+	Cost    int                 `json:"cost"`
+	Message string              `json:"message"`
+}
+
+// IncomeFactor is one multiplier applied to a hotspot's base income
+type IncomeFactor struct {
+	Source     string  `json:"source"` // business, title, heat, neighborhood
+	Label      string  `json:"label"`
+	Multiplier float64 `json:"multiplier"`
+}
+
+// IncomeBreakdown itemizes how a hotspot's hourly income was reached
+type IncomeBreakdown struct {
+	HotspotType  string         `json:"hotspotType"`
+	BaseRate     int            `json:"baseRate"`
+	Factors      []IncomeFactor `json:"factors"`
+	HourlyIncome int            `json:"hourlyIncome"`
+}
+
+// PoliceRaid describes what a police raid took from a hotspot
+type PoliceRaid struct {
+	HotspotID    string     `json:"hotspotId"`
+	HotspotName  string     `json:"hotspotName"`
+	ControllerID string     `json:"controllerId"`
+	MoneySeized  int        `json:"moneySeized"`
+	CrewLost     int        `json:"crewLost"`
+	WeaponsLost  int        `json:"weaponsLost"`
+	VehiclesLost int        `json:"vehiclesLost"`
+	ClosedUntil  *time.Time `json:"closedUntil,omitempty"`
+}
@@ -0,0 +1,136 @@
+// internal/repository/admin.go
+
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"mwce-be/internal/model"
+	"mwce-be/pkg/database"
+
+	"gorm.io/gorm"
+)
+
+// AdminRepository handles database operations for the admin back office
+type AdminRepository interface {
+	CreateAuditLog(entry *model.AdminAuditLog) error
+	GetAuditLogs(limit int) ([]model.AdminAuditLog, error)
+	GetAuditLogsByActor(actorID string, limit int) ([]model.AdminAuditLog, error)
+	ApplySanction(sanction *model.PlayerSanction, status, reason string, expiresAt *time.Time) error
+	GetSanctionsByPlayer(playerID string) ([]model.PlayerSanction, error)
+	ApplyResourceAdjustment(adjustment *model.ResourceAdjustment) error
+	GetResourceAdjustmentsByPlayer(playerID string) ([]model.ResourceAdjustment, error)
+	CreateOperatorCommandLog(entry *model.OperatorCommandLog) error
+}
+
+type adminRepository struct {
+	db database.Database
+}
+
+// NewAdminRepository creates a new admin repository
+func NewAdminRepository(db database.Database) AdminRepository {
+	return &adminRepository{
+		db: db,
+	}
+}
+
+// CreateAuditLog stores a new audit log entry
+func (r *adminRepository) CreateAuditLog(entry *model.AdminAuditLog) error {
+	return r.db.GetDB().Create(entry).Error
+}
+
+// GetAuditLogs retrieves the most recent audit log entries
+func (r *adminRepository) GetAuditLogs(limit int) ([]model.AdminAuditLog, error) {
+	var entries []model.AdminAuditLog
+	if err := r.db.GetDB().Order("timestamp DESC").Limit(limit).Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// GetAuditLogsByActor retrieves the most recent audit log entries for a single actor
+func (r *adminRepository) GetAuditLogsByActor(actorID string, limit int) ([]model.AdminAuditLog, error) {
+	var entries []model.AdminAuditLog
+	if err := r.db.GetDB().Where("actor_id = ?", actorID).Order("timestamp DESC").Limit(limit).Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// ApplySanction sets a player's account status and records the sanction behind it in one transaction
+func (r *adminRepository) ApplySanction(sanction *model.PlayerSanction, status, reason string, expiresAt *time.Time) error {
+	return r.db.GetDB().Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.Player{}).Where("id = ?", sanction.PlayerID).Updates(map[string]interface{}{
+			"account_status":    status,
+			"status_reason":     reason,
+			"status_expires_at": expiresAt,
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errors.New("player not found")
+		}
+
+		return tx.Create(sanction).Error
+	})
+}
+
+// GetSanctionsByPlayer retrieves all sanctions applied to a player
+func (r *adminRepository) GetSanctionsByPlayer(playerID string) ([]model.PlayerSanction, error) {
+	var sanctions []model.PlayerSanction
+	if err := r.db.GetDB().Where("player_id = ?", playerID).Order("created_at DESC").Find(&sanctions).Error; err != nil {
+		return nil, err
+	}
+	return sanctions, nil
+}
+
+// ApplyResourceAdjustment changes a player's resources and records the adjustment in one transaction.
+// Resources never drop below zero.
+func (r *adminRepository) ApplyResourceAdjustment(adjustment *model.ResourceAdjustment) error {
+	changes := map[string]int{
+		"money":     adjustment.Money,
+		"crew":      adjustment.Crew,
+		"weapons":   adjustment.Weapons,
+		"vehicles":  adjustment.Vehicles,
+		"respect":   adjustment.Respect,
+		"influence": adjustment.Influence,
+		"heat":      adjustment.Heat,
+	}
+
+	updates := map[string]interface{}{
+		"last_active": time.Now(),
+	}
+	for column, amount := range changes {
+		if amount != 0 {
+			updates[column] = gorm.Expr("GREATEST(0, "+column+" + ?)", amount)
+		}
+	}
+
+	return r.db.GetDB().Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.Player{}).Where("id = ?", adjustment.PlayerID).Updates(updates)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errors.New("player not found")
+		}
+
+		return tx.Create(adjustment).Error
+	})
+}
+
+// GetResourceAdjustmentsByPlayer retrieves all resource adjustments applied to a player
+func (r *adminRepository) GetResourceAdjustmentsByPlayer(playerID string) ([]model.ResourceAdjustment, error) {
+	var adjustments []model.ResourceAdjustment
+	if err := r.db.GetDB().Where("player_id = ?", playerID).Order("created_at DESC").Find(&adjustments).Error; err != nil {
+		return nil, err
+	}
+	return adjustments, nil
+}
+
+// CreateOperatorCommandLog stores a new operator CLI command log entry
+func (r *adminRepository) CreateOperatorCommandLog(entry *model.OperatorCommandLog) error {
+	return r.db.GetDB().Create(entry).Error
+}
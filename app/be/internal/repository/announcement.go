@@ -0,0 +1,105 @@
+// internal/repository/announcement.go
+
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"mwce-be/internal/model"
+	"mwce-be/pkg/database"
+
+	"gorm.io/gorm"
+)
+
+// AnnouncementRepository handles database operations for announcements
+type AnnouncementRepository interface {
+	CreateAnnouncement(announcement *model.Announcement) error
+	GetAnnouncementByID(id string) (*model.Announcement, error)
+	UpdateAnnouncement(announcement *model.Announcement) error
+	GetAnnouncements(limit int) ([]model.Announcement, error)
+	GetActiveAnnouncements(now time.Time) ([]model.Announcement, error)
+	GetUndeliveredAnnouncements(now time.Time) ([]model.Announcement, error)
+	MarkAnnouncementDelivered(id string, deliveredAt time.Time) (bool, error)
+}
+
+type announcementRepository struct {
+	db database.Database
+}
+
+// NewAnnouncementRepository creates a new announcement repository
+func NewAnnouncementRepository(db database.Database) AnnouncementRepository {
+	return &announcementRepository{
+		db: db,
+	}
+}
+
+// CreateAnnouncement creates a new announcement
+func (r *announcementRepository) CreateAnnouncement(announcement *model.Announcement) error {
+	return r.db.GetDB().Create(announcement).Error
+}
+
+// GetAnnouncementByID retrieves an announcement by ID
+func (r *announcementRepository) GetAnnouncementByID(id string) (*model.Announcement, error) {
+	var announcement model.Announcement
+	if err := r.db.GetDB().Where("id = ?", id).First(&announcement).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("announcement not found")
+		}
+		return nil, err
+	}
+	return &announcement, nil
+}
+
+// UpdateAnnouncement updates an announcement
+func (r *announcementRepository) UpdateAnnouncement(announcement *model.Announcement) error {
+	return r.db.GetDB().Save(announcement).Error
+}
+
+// GetAnnouncements retrieves the most recent announcements
+func (r *announcementRepository) GetAnnouncements(limit int) ([]model.Announcement, error) {
+	var announcements []model.Announcement
+	if err := r.db.GetDB().Order("starts_at DESC").Limit(limit).Find(&announcements).Error; err != nil {
+		return nil, err
+	}
+	return announcements, nil
+}
+
+// GetActiveAnnouncements retrieves announcements that are currently running
+func (r *announcementRepository) GetActiveAnnouncements(now time.Time) ([]model.Announcement, error) {
+	var announcements []model.Announcement
+	if err := r.db.GetDB().
+		Where("starts_at <= ? AND ends_at > ? AND cancelled_at IS NULL", now, now).
+		Order("starts_at DESC").
+		Find(&announcements).Error; err != nil {
+		return nil, err
+	}
+	return announcements, nil
+}
+
+// GetUndeliveredAnnouncements retrieves running announcements that have not been broadcast yet
+func (r *announcementRepository) GetUndeliveredAnnouncements(now time.Time) ([]model.Announcement, error) {
+	var announcements []model.Announcement
+	if err := r.db.GetDB().
+		Where("starts_at <= ? AND ends_at > ? AND cancelled_at IS NULL AND delivered_at IS NULL", now, now).
+		Order("starts_at").
+		Find(&announcements).Error; err != nil {
+		return nil, err
+	}
+	return announcements, nil
+}
+
+// MarkAnnouncementDelivered claims an announcement for delivery.
+// It returns false when the announcement was already delivered or has been cancelled.
+func (r *announcementRepository) MarkAnnouncementDelivered(id string, deliveredAt time.Time) (bool, error) {
+	result := r.db.GetDB().Model(&model.Announcement{}).
+		Where("id = ? AND delivered_at IS NULL AND cancelled_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"delivered_at": deliveredAt,
+			"updated_at":   deliveredAt,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
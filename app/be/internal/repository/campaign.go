@@ -50,6 +50,7 @@ type CampaignRepository interface {
 
 	// Campaign Data Management
 	CreateCampaign(campaign *model.Campaign) error
+	UpdateCampaign(campaign *model.Campaign) error
 	CreateChapter(chapter *model.Chapter) error
 	CreateMission(mission *model.Mission) error
 	CreateBranch(branch *model.Branch) error
@@ -395,6 +396,13 @@ func (r *campaignRepository) CreateCampaign(campaign *model.Campaign) error {
 	return r.db.GetDB().Create(campaign).Error
 }
 
+// UpdateCampaign updates a campaign's own fields without touching its chapters
+func (r *campaignRepository) UpdateCampaign(campaign *model.Campaign) error {
+	return r.db.GetDB().Model(campaign).
+		Select("name", "description", "image_url", "is_active", "updated_at").
+		Updates(campaign).Error
+}
+
 // CreateChapter creates a new chapter
 func (r *campaignRepository) CreateChapter(chapter *model.Chapter) error {
 	return r.db.GetDB().Create(chapter).Error
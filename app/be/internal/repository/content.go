@@ -0,0 +1,65 @@
+// internal/repository/content.go
+
+package repository
+
+import (
+	"mwce-be/internal/model"
+	"mwce-be/pkg/database"
+)
+
+// ContentRepository handles database operations for content versioning
+type ContentRepository interface {
+	CreateContentVersion(version *model.ContentVersion) error
+	GetContentVersions(entityType, entityID string, limit int) ([]model.ContentVersion, error)
+}
+
+type contentRepository struct {
+	db database.Database
+}
+
+// NewContentRepository creates a new content repository
+func NewContentRepository(db database.Database) ContentRepository {
+	return &contentRepository{
+		db: db,
+	}
+}
+
+// CreateContentVersion stores a new content version, numbered after the entity's latest one.
+// It must run inside the transaction that changes the content: the advisory lock serialises
+// numbering per entity until that transaction ends.
+func (r *contentRepository) CreateContentVersion(version *model.ContentVersion) error {
+	db := r.db.GetDB()
+
+	if err := db.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", version.EntityType+":"+version.EntityID).Error; err != nil {
+		return err
+	}
+
+	var latest int
+	if err := db.Model(&model.ContentVersion{}).
+		Select("COALESCE(MAX(version), 0)").
+		Where("entity_type = ? AND entity_id = ?", version.EntityType, version.EntityID).
+		Scan(&latest).Error; err != nil {
+		return err
+	}
+
+	version.Version = latest + 1
+	return db.Create(version).Error
+}
+
+// GetContentVersions retrieves content versions, optionally filtered by entity type and ID
+func (r *contentRepository) GetContentVersions(entityType, entityID string, limit int) ([]model.ContentVersion, error) {
+	var versions []model.ContentVersion
+	db := r.db.GetDB().Order("created_at DESC").Limit(limit)
+
+	if entityType != "" {
+		db = db.Where("entity_type = ?", entityType)
+	}
+	if entityID != "" {
+		db = db.Where("entity_id = ?", entityID)
+	}
+
+	if err := db.Find(&versions).Error; err != nil {
+		return nil, err
+	}
+	return versions, nil
+}
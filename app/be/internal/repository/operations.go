@@ -28,6 +28,10 @@ type OperationsRepository interface {
 	GetCompletedOperations(playerID string) ([]model.OperationAttempt, error)
 	CreateOperationAttempt(attempt *model.OperationAttempt) error
 	UpdateOperationAttempt(attempt *model.OperationAttempt) error
+	GetOperationTemplates() ([]model.OperationTemplate, error)
+	GetOperationTemplateByID(id string) (*model.OperationTemplate, error)
+	SaveOperationTemplate(template *model.OperationTemplate) error
+	CountSuccessfulOperationsSince(playerID, operationType string, since time.Time) (int64, error)
 }
 
 type operationsRepository struct {
@@ -158,3 +162,43 @@ func (r *operationsRepository) CreateOperationAttempt(attempt *model.OperationAt
 func (r *operationsRepository) UpdateOperationAttempt(attempt *model.OperationAttempt) error {
 	return r.db.GetDB().Save(attempt).Error
 }
+
+// GetOperationTemplates retrieves all admin-managed operation templates
+func (r *operationsRepository) GetOperationTemplates() ([]model.OperationTemplate, error) {
+	var templates []model.OperationTemplate
+	if err := r.db.GetDB().Order("created_at").Find(&templates).Error; err != nil {
+		return nil, err
+	}
+	return templates, nil
+}
+
+// GetOperationTemplateByID retrieves an admin-managed operation template by ID
+func (r *operationsRepository) GetOperationTemplateByID(id string) (*model.OperationTemplate, error) {
+	var template model.OperationTemplate
+	if err := r.db.GetDB().Where("id = ?", id).First(&template).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("operation template not found")
+		}
+		return nil, err
+	}
+	return &template, nil
+}
+
+// SaveOperationTemplate creates or updates an admin-managed operation template
+func (r *operationsRepository) SaveOperationTemplate(template *model.OperationTemplate) error {
+	return r.db.GetDB().Save(template).Error
+}
+
+// CountSuccessfulOperationsSince counts a player's successful operations of one type completed since a point in time
+func (r *operationsRepository) CountSuccessfulOperationsSince(playerID, operationType string, since time.Time) (int64, error) {
+	var count int64
+	err := r.db.GetDB().Model(&model.OperationAttempt{}).
+		Joins("JOIN operations ON operations.id = operation_attempts.operation_id").
+		Where("operation_attempts.player_id = ?", playerID).
+		Where("operations.type = ?", operationType).
+		Where("operation_attempts.status = ?", util.OperationStatusCompleted).
+		Where("operation_attempts.success = ?", true).
+		Where("operation_attempts.completion_time >= ?", since).
+		Count(&count).Error
+	return count, err
+}
@@ -19,6 +19,10 @@ type PlayerRepository interface {
 	GetPlayerByID(id string) (*model.Player, error)
 	GetPlayerByEmail(email string) (*model.Player, error)
 	UpdatePlayer(player *model.Player) error
+	UpdatePlayerRole(playerID, role string) error
+	UpdatePlayerStatus(playerID, status, reason string, expiresAt *time.Time) error
+	SearchPlayers(query string, limit int) ([]model.Player, error)
+	GetAllPlayers() ([]model.Player, error)
 	DeletePlayer(id string) error
 	GetPlayerStats(playerID string) (*model.PlayerStats, error)
 	UpdatePlayerStats(stats *model.PlayerStats) error
@@ -32,10 +36,28 @@ type PlayerRepository interface {
 	CalculateHourlyRevenue(playerID string) (int, error)
 	CalculatePendingCollections(playerID string) (int, error)
 	CollectAllPending(playerID string) (int, error)
+	GetPlayersArrivingBy(now time.Time) ([]model.Player, error)
 	// New travel-related methods
 	CreateTravelAttempt(attempt *model.TravelAttempt) error
 	GetTravelHistory(playerID string, limit int) ([]model.TravelAttempt, error)
 	GetPlayerCurrentRegion(playerID string) (*string, error)
+	// Heat-related methods
+	GetPlayersWithHeatActivity() ([]model.Player, error)
+	GetPlayersWithHeatAtLeast(minHeat int) ([]model.Player, error)
+	ApplyHeatDecay(playerID string, decay, maxHeat int, updatedAt time.Time) (int, error)
+	UpdateHeatWarningLevel(playerID string, level int) error
+	RecordHeatSample(playerID string, heat int, timestamp time.Time) error
+	GetHeatHistory(playerID string, since time.Time) ([]model.HeatHistory, error)
+	// Protection-related methods
+	UpdatePlayerProtection(playerID string, protectedUntil *time.Time) error
+	// Travel-related methods
+	StartTrip(player *model.Player, trip *model.Trip) error
+	GetTrip(playerID string) (*model.Trip, error)
+	CompleteTrip(playerID string, regionID *string, fine, heatChange int, jailedUntil *time.Time, arrivedAt time.Time) (bool, error)
+	// Failure streak methods
+	GetFailureStreaks(playerID string) ([]model.FailureStreak, error)
+	GetFailureStreak(playerID, category string) (int, error)
+	RecordFailureStreak(playerID, category string, failed bool) error
 }
 
 type playerRepository struct {
@@ -167,6 +189,59 @@ func (r *playerRepository) UpdatePlayer(player *model.Player) error {
 	return r.db.GetDB().Save(player).Error
 }
 
+// UpdatePlayerRole updates a player's access role
+func (r *playerRepository) UpdatePlayerRole(playerID, role string) error {
+	result := r.db.GetDB().Model(&model.Player{}).Where("id = ?", playerID).Update("role", role)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("player not found")
+	}
+	return nil
+}
+
+// UpdatePlayerStatus updates a player's account status
+func (r *playerRepository) UpdatePlayerStatus(playerID, status, reason string, expiresAt *time.Time) error {
+	result := r.db.GetDB().Model(&model.Player{}).Where("id = ?", playerID).Updates(map[string]interface{}{
+		"account_status":    status,
+		"status_reason":     reason,
+		"status_expires_at": expiresAt,
+	})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("player not found")
+	}
+	return nil
+}
+
+// SearchPlayers finds players by ID, name or email
+func (r *playerRepository) SearchPlayers(query string, limit int) ([]model.Player, error) {
+	var players []model.Player
+	db := r.db.GetDB().Order("last_active DESC").Limit(limit)
+
+	if query != "" {
+		pattern := "%" + query + "%"
+		db = db.Where("id::text = ? OR name ILIKE ? OR email ILIKE ?", query, pattern, pattern)
+	}
+
+	if err := db.Find(&players).Error; err != nil {
+		return nil, err
+	}
+	return players, nil
+}
+
+// GetAllPlayers retrieves every player without calculated fields
+func (r *playerRepository) GetAllPlayers() ([]model.Player, error) {
+	var players []model.Player
+	if err := r.db.GetDB().Find(&players).Error; err != nil {
+		return nil, err
+	}
+	return players, nil
+}
+
 // DeletePlayer deletes a player from the database
 func (r *playerRepository) DeletePlayer(id string) error {
 	return r.db.GetDB().Delete(&model.Player{}, "id = ?", id).Error
@@ -289,41 +364,61 @@ func (r *playerRepository) CalculatePendingCollections(playerID string) (int, er
 	var total sql.NullInt64
 	if err := r.db.GetDB().Model(&model.Hotspot{}).
 		Where("controller_id = ?", playerID).
-		Select("COALESCE(SUM(pending_collection),0)").
+		Select("COALESCE(SUM(" + pendingWithAccruedSQL + "), 0)").
 		Scan(&total).Error; err != nil {
 		return 0, err
 	}
 	return int(total.Int64), nil
 }
 
-// CollectAllPending collects all pending resources for a player
+// CollectAllPending collects all pending resources for a player, including income accrued since the last payout
 func (r *playerRepository) CollectAllPending(playerID string) (int, error) {
-	var pendingTotal int64
+	var pendingTotal sql.NullInt64
+
+	err := r.db.GetDB().Transaction(func(tx *gorm.DB) error {
+		// Get the total pending amount
+		if err := tx.Model(&model.Hotspot{}).
+			Where("controller_id = ? AND "+openHotspotSQL, playerID).
+			Select("COALESCE(SUM(" + pendingWithAccruedSQL + "), 0)").
+			Scan(&pendingTotal).Error; err != nil {
+			return err
+		}
 
-	// Get the total pending amount
-	if err := r.db.GetDB().Model(&model.Hotspot{}).
-		Where("controller_id = ?", playerID).
-		Select("SUM(pending_collection)").
-		Scan(&pendingTotal).Error; err != nil {
-		return 0, err
-	}
+		// Reset pending collections on all hotspots and move their income clocks past the hours paid out
+		if err := tx.Model(&model.Hotspot{}).
+			Where("controller_id = ? AND "+openHotspotSQL, playerID).
+			Updates(map[string]interface{}{
+				"pending_collection":   0,
+				"last_income_time":     gorm.Expr("last_income_time + (" + accruedIncomeHoursSQL + ") * INTERVAL '1 hour'"),
+				"last_collection_time": time.Now(),
+			}).Error; err != nil {
+			return err
+		}
 
-	// Reset pending collections on all hotspots
-	if err := r.db.GetDB().Model(&model.Hotspot{}).
-		Where("controller_id = ?", playerID).
-		Updates(map[string]interface{}{
-			"pending_collection":   0,
-			"last_collection_time": time.Now(),
-		}).Error; err != nil {
+		return nil
+	})
+	if err != nil {
 		return 0, err
 	}
 
 	// Update player's money
-	if err := r.UpdatePlayerResource(playerID, "money", int(pendingTotal)); err != nil {
+	if err := r.UpdatePlayerResource(playerID, "money", int(pendingTotal.Int64)); err != nil {
 		return 0, err
 	}
 
-	return int(pendingTotal), nil
+	return int(pendingTotal.Int64), nil
+}
+
+// GetPlayersArrivingBy retrieves players in transit whose arrival time has passed
+func (r *playerRepository) GetPlayersArrivingBy(now time.Time) ([]model.Player, error) {
+	var players []model.Player
+	if err := r.db.GetDB().
+		Where("in_transit_to IS NOT NULL AND arrival_time <= ?", now).
+		Find(&players).Error; err != nil {
+		return nil, err
+	}
+
+	return players, nil
 }
 
 // CreateTravelAttempt creates a new travel attempt record
@@ -426,7 +521,7 @@ func (r *playerRepository) GetRegionalStatistics(playerID, regionID string) (*Re
 		Model(&model.Hotspot{}).
 		Where("controller_id = ?", playerID).
 		Where("(?) = ?", subquery2, regionID).
-		Select("COALESCE(SUM(pending_collection), 0)").
+		Select("COALESCE(SUM(" + pendingWithAccruedSQL + "), 0)").
 		Scan(&pending).Error; err != nil {
 		return nil, err
 	}
@@ -533,10 +628,200 @@ func (r *playerRepository) CalculatePendingCollectionsInRegion(playerID, regionI
 		Model(&model.Hotspot{}).
 		Where("controller_id = ?", playerID).
 		Where("(?) = ?", subquery2, regionID).
-		Select("COALESCE(SUM(pending_collection), 0)").
+		Select("COALESCE(SUM(" + pendingWithAccruedSQL + "), 0)").
 		Scan(&total).Error; err != nil {
 		return 0, err
 	}
 
 	return int(total.Int64), nil
 }
+
+// GetPlayersWithHeatActivity retrieves players whose heat needs decaying, sampling or a warning reset
+func (r *playerRepository) GetPlayersWithHeatActivity() ([]model.Player, error) {
+	var players []model.Player
+	if err := r.db.GetDB().
+		Where("heat > 0 OR heat_warning_level > 0 OR heat <> last_heat_sample").
+		Find(&players).Error; err != nil {
+		return nil, err
+	}
+	return players, nil
+}
+
+// GetPlayersWithHeatAtLeast retrieves players whose heat has reached a threshold
+func (r *playerRepository) GetPlayersWithHeatAtLeast(minHeat int) ([]model.Player, error) {
+	var players []model.Player
+	if err := r.db.GetDB().
+		Where("heat >= ?", minHeat).
+		Order("heat DESC, id ASC").
+		Find(&players).Error; err != nil {
+		return nil, err
+	}
+	return players, nil
+}
+
+// ApplyHeatDecay lowers a player's heat, clamps it to the maximum and returns the new value
+func (r *playerRepository) ApplyHeatDecay(playerID string, decay, maxHeat int, updatedAt time.Time) (int, error) {
+	var heat int
+	if err := r.db.GetDB().Raw(
+		"UPDATE players SET heat = LEAST(?, GREATEST(0, heat - ?)), heat_updated_at = ? WHERE id = ? RETURNING heat",
+		maxHeat, decay, updatedAt, playerID,
+	).Scan(&heat).Error; err != nil {
+		return 0, err
+	}
+	return heat, nil
+}
+
+// UpdateHeatWarningLevel stores how many heat warning thresholds a player has crossed
+func (r *playerRepository) UpdateHeatWarningLevel(playerID string, level int) error {
+	return r.db.GetDB().Model(&model.Player{}).
+		Where("id = ?", playerID).
+		Update("heat_warning_level", level).Error
+}
+
+// RecordHeatSample stores a heat history sample and remembers it on the player
+func (r *playerRepository) RecordHeatSample(playerID string, heat int, timestamp time.Time) error {
+	return r.db.GetDB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&model.HeatHistory{
+			PlayerID:  playerID,
+			Heat:      heat,
+			Timestamp: timestamp,
+		}).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&model.Player{}).
+			Where("id = ?", playerID).
+			Update("last_heat_sample", heat).Error
+	})
+}
+
+// GetHeatHistory retrieves a player's heat samples since a point in time
+func (r *playerRepository) GetHeatHistory(playerID string, since time.Time) ([]model.HeatHistory, error) {
+	var history []model.HeatHistory
+	if err := r.db.GetDB().
+		Where("player_id = ? AND timestamp >= ?", playerID, since).
+		Order("timestamp ASC").
+		Find(&history).Error; err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+// UpdatePlayerProtection sets or clears the player's loss shield
+func (r *playerRepository) UpdatePlayerProtection(playerID string, protectedUntil *time.Time) error {
+	return r.db.GetDB().Model(&model.Player{}).
+		Where("id = ?", playerID).
+		Update("protected_until", protectedUntil).Error
+}
+
+// StartTrip saves the player setting off and the trip they planned, replacing any trip left over from before
+func (r *playerRepository) StartTrip(player *model.Player, trip *model.Trip) error {
+	return r.db.GetDB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(player).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("player_id = ?", trip.PlayerID).Delete(&model.Trip{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(trip).Error
+	})
+}
+
+// GetTrip retrieves the trip a player is on, or nil when none was stored
+func (r *playerRepository) GetTrip(playerID string) (*model.Trip, error) {
+	var trips []model.Trip
+	if err := r.db.GetDB().Where("player_id = ?", playerID).Limit(1).Find(&trips).Error; err != nil {
+		return nil, err
+	}
+	if len(trips) == 0 {
+		return nil, nil
+	}
+	return &trips[0], nil
+}
+
+// CompleteTrip ends a player's trip and applies its outcome, touching only the travel columns.
+// A negative fine refunds the player. It reports false when the trip was already ended by another caller.
+func (r *playerRepository) CompleteTrip(playerID string, regionID *string, fine, heatChange int, jailedUntil *time.Time, arrivedAt time.Time) (bool, error) {
+	updates := map[string]interface{}{
+		"in_transit_to":     nil,
+		"arrival_time":      nil,
+		"last_travel_time":  arrivedAt,
+		"current_region_id": regionID,
+		"money":             gorm.Expr("GREATEST(0, money - ?)", fine),
+		"heat":              gorm.Expr("GREATEST(0, heat + ?)", heatChange),
+	}
+	if jailedUntil != nil {
+		updates["jailed_until"] = jailedUntil
+	}
+
+	claimed := false
+	err := r.db.GetDB().Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.Player{}).
+			Where("id = ? AND in_transit_to IS NOT NULL", playerID).
+			Updates(updates)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		claimed = true
+
+		return tx.Where("player_id = ?", playerID).Delete(&model.Trip{}).Error
+	})
+	return claimed, err
+}
+
+// GetFailureStreaks retrieves every failure streak a player has
+func (r *playerRepository) GetFailureStreaks(playerID string) ([]model.FailureStreak, error) {
+	var streaks []model.FailureStreak
+	if err := r.db.GetDB().Where("player_id = ?", playerID).Find(&streaks).Error; err != nil {
+		return nil, err
+	}
+	return streaks, nil
+}
+
+// GetFailureStreak retrieves a player's current failure streak in a category, zero when there is none
+func (r *playerRepository) GetFailureStreak(playerID, category string) (int, error) {
+	var streaks []model.FailureStreak
+	if err := r.db.GetDB().
+		Where("player_id = ? AND category = ?", playerID, category).
+		Limit(1).
+		Find(&streaks).Error; err != nil {
+		return 0, err
+	}
+	if len(streaks) == 0 {
+		return 0, nil
+	}
+	return streaks[0].Count, nil
+}
+
+// RecordFailureStreak extends a player's failure streak in a category, or resets it after a success
+func (r *playerRepository) RecordFailureStreak(playerID, category string, failed bool) error {
+	now := time.Now()
+
+	if !failed {
+		return r.db.GetDB().Model(&model.FailureStreak{}).
+			Where("player_id = ? AND category = ? AND count > 0", playerID, category).
+			Updates(map[string]interface{}{"count": 0, "updated_at": now}).Error
+	}
+
+	return r.db.GetDB().Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.FailureStreak{}).
+			Where("player_id = ? AND category = ?", playerID, category).
+			Updates(map[string]interface{}{"count": gorm.Expr("count + 1"), "updated_at": now})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected > 0 {
+			return nil
+		}
+
+		return tx.Create(&model.FailureStreak{
+			PlayerID:  playerID,
+			Category:  category,
+			Count:     1,
+			UpdatedAt: now,
+		}).Error
+	})
+}
@@ -7,11 +7,23 @@ import (
 	"time"
 
 	"mwce-be/internal/model"
+	"mwce-be/internal/util"
 	"mwce-be/pkg/database"
 
 	"gorm.io/gorm"
 )
 
+// Income accrues lazily for every full hour since last_income_time at the hotspot's hourly rate.
+// These expressions let queries include income that has been earned but not yet materialized.
+const (
+	accruedIncomeHoursSQL = "CASE WHEN is_legal AND controller_id IS NOT NULL AND last_income_time IS NOT NULL " +
+		"THEN FLOOR(EXTRACT(EPOCH FROM (NOW() - last_income_time)) / 3600)::int ELSE 0 END"
+	pendingWithAccruedSQL = "pending_collection + (" + accruedIncomeHoursSQL + ") * income"
+
+	// openHotspotSQL leaves out hotspots a police raid has shut down, whose income can't be collected
+	openHotspotSQL = "(closed_until IS NULL OR closed_until <= NOW())"
+)
+
 // TerritoryRepository handles database operations for territories
 type TerritoryRepository interface {
 	GetDB() *gorm.DB
@@ -35,10 +47,69 @@ type TerritoryRepository interface {
 	GetRecentActionsByPlayer(playerID string, limit int) ([]model.TerritoryAction, error)
 	GetRecentActionsByPlayerAndRegion(playerID string, regionID string, limit int) ([]model.TerritoryAction, error)
 	UpdateHotspotPendingCollection(hotspotID string, amount int) error
-	RefreshIllegalBusinesses() error
+	RotateIllegalHotspots(cityID string, spawned []model.Hotspot) (int, error)
+	GetLastIllegalRotation() (*time.Time, error)
+	GetLegalHotspotControlCounts() ([]model.HotspotControlCount, error)
+	GetHotspotRegionID(hotspotID string) (string, error)
+	CountHotspotsLostSince(playerID string, since time.Time) (int, error)
+	UpdateHotspotGarrisons(hotspots []model.Hotspot) error
+
+	// Garrison transfers
+	CreateGarrisonTransfer(transfer *model.GarrisonTransfer) error
+	UpdateGarrisonTransfer(transfer *model.GarrisonTransfer) error
+	GetDueGarrisonTransfers(now time.Time) ([]model.GarrisonTransfer, error)
+	GetGarrisonTransfersInTransit(playerID string) ([]model.GarrisonTransfer, error)
+
+	// Fortifications
+	GetHotspotUpgrades(hotspotID string) ([]model.HotspotUpgrade, error)
+	SaveHotspotUpgrade(upgrade *model.HotspotUpgrade) error
+	StartHotspotUpgrade(upgrade *model.HotspotUpgrade, playerID string, cost int) error
+	GetDueHotspotUpgrades(now time.Time) ([]model.HotspotUpgrade, error)
+
+	// Police raids
+	ApplyPoliceRaid(raid *model.PoliceRaid, accrued int, incomeTimeBefore, incomeTimeAfter *time.Time) (bool, error)
+	UpdateHotspotDefenseStrength(hotspotID string, defenseStrength int) error
+
+	// Local heat
+	AddLocalHeat(cityID, regionID string, cityAmount, regionAmount, maxHeat int) (int, error)
+	AddRegionHeat(regionIDs []string, amount, maxHeat int) error
+	DecayLocalHeat(amount int) error
+	StartCityCrackdown(cityID string, until time.Time, heatRelief int) (bool, error)
+
+	// Ownership history
+	RecordOwnershipChange(hotspotID string, newControllerID *string, how string, at time.Time) error
+	GetHotspotOwnership(hotspotID string) ([]model.HotspotOwnership, error)
+	GetRecentActionsByHotspot(hotspotID string, limit int) ([]model.TerritoryAction, error)
+
+	// Staged assaults
+	CreateAssault(assault *model.Assault) error
+	UpdateAssault(assault *model.Assault) error
+	ClaimAssault(id string) (bool, error)
+	GetDueAssaults(now time.Time) ([]model.Assault, error)
+	GetUnspottedAssaults(now time.Time) ([]model.Assault, error)
+	GetPendingAssaultsByHotspot(hotspotID string) ([]model.Assault, error)
+	GetPendingAssaultsByPlayer(playerID string) ([]model.Assault, error)
+
 	GetAllControlledLegalHotspots() ([]model.Hotspot, error)
 	GetAllControlledLegalHotspotsByRegion(regionID string) ([]model.Hotspot, error)
 	UpdateHotspotLastIncomeTime(hotspotID string, lastIncomeTime time.Time) error
+
+	// Content management
+	CreateRegion(region *model.Region) error
+	UpdateRegion(region *model.Region) error
+	RetireRegion(id string) error
+	GetRegionRoutes() ([]model.RegionRoute, error)
+	SetRegionRoutes(regionID string, routes []model.RegionRoute) error
+	CreateRegionRoutes(routes []model.RegionRoute) error
+	CreateDistrict(district *model.District) error
+	UpdateDistrict(district *model.District) error
+	RetireDistrict(id string) error
+	CreateCity(city *model.City) error
+	UpdateCity(city *model.City) error
+	RetireCity(id string) error
+	CreateHotspot(hotspot *model.Hotspot) error
+	UpdateHotspotContent(hotspotID string, fields map[string]interface{}) error
+	RetireHotspot(id string) error
 }
 
 type territoryRepository struct {
@@ -159,11 +230,12 @@ func (r *territoryRepository) GetAllHotspots() ([]model.Hotspot, error) {
 		if hotspot.ControllerID != nil {
 			var player model.Player
 			if err := r.db.GetDB().
-				Select("name").
+				Select("name", "protected_until").
 				Where("id = ?", *hotspot.ControllerID).
 				First(&player).Error; err == nil {
 				controllerName := player.Name
 				hotspots[i].ControllerName = &controllerName
+				hotspots[i].ProtectedUntil = activeProtection(&player)
 			}
 		}
 	}
@@ -183,11 +255,12 @@ func (r *territoryRepository) GetHotspotsByCity(cityID string) ([]model.Hotspot,
 		if hotspot.ControllerID != nil {
 			var player model.Player
 			if err := r.db.GetDB().
-				Select("name").
+				Select("name", "protected_until").
 				Where("id = ?", *hotspot.ControllerID).
 				First(&player).Error; err == nil {
 				controllerName := player.Name
 				hotspots[i].ControllerName = &controllerName
+				hotspots[i].ProtectedUntil = activeProtection(&player)
 			}
 		}
 	}
@@ -240,11 +313,12 @@ func (r *territoryRepository) GetHotspotsByRegion(regionID string) ([]model.Hots
 		if hotspot.ControllerID != nil {
 			var player model.Player
 			if err := r.db.GetDB().
-				Select("name").
+				Select("name", "protected_until").
 				Where("id = ?", *hotspot.ControllerID).
 				First(&player).Error; err == nil {
 				controllerName := player.Name
 				hotspots[i].ControllerName = &controllerName
+				hotspots[i].ProtectedUntil = activeProtection(&player)
 			}
 		}
 	}
@@ -266,11 +340,12 @@ func (r *territoryRepository) GetHotspotByID(id string) (*model.Hotspot, error)
 	if hotspot.ControllerID != nil {
 		var player model.Player
 		if err := r.db.GetDB().
-			Select("name").
+			Select("name", "protected_until").
 			Where("id = ?", *hotspot.ControllerID).
 			First(&player).Error; err == nil {
 			controllerName := player.Name
 			hotspot.ControllerName = &controllerName
+			hotspot.ProtectedUntil = activeProtection(&player)
 		}
 	}
 
@@ -280,19 +355,20 @@ func (r *territoryRepository) GetHotspotByID(id string) (*model.Hotspot, error)
 // GetControlledHotspots retrieves hotspots controlled by a player
 func (r *territoryRepository) GetControlledHotspots(playerID string) ([]model.Hotspot, error) {
 	var hotspots []model.Hotspot
-	if err := r.db.GetDB().Where("controller_id = ?", playerID).Find(&hotspots).Error; err != nil {
+	if err := r.db.GetDB().Where("controller_id = ?", playerID).Order("name ASC, id ASC").Find(&hotspots).Error; err != nil {
 		return nil, err
 	}
 
 	// Set controller name for all hotspots
 	var player model.Player
 	if err := r.db.GetDB().
-		Select("name").
+		Select("name", "protected_until").
 		Where("id = ?", playerID).
 		First(&player).Error; err == nil {
 		for i := range hotspots {
 			controllerName := player.Name
 			hotspots[i].ControllerName = &controllerName
+			hotspots[i].ProtectedUntil = activeProtection(&player)
 		}
 	}
 
@@ -318,22 +394,22 @@ func (r *territoryRepository) GetControlledHotspotsByRegion(playerID string, reg
 	// Set controller name for all hotspots
 	var player model.Player
 	if err := r.db.GetDB().
-		Select("name").
+		Select("name", "protected_until").
 		Where("id = ?", playerID).
 		First(&player).Error; err == nil {
 		for i := range controlledHotspots {
 			controllerName := player.Name
 			controlledHotspots[i].ControllerName = &controllerName
+			controlledHotspots[i].ProtectedUntil = activeProtection(&player)
 		}
 	}
 
 	return controlledHotspots, nil
 }
 
-// UpdateHotspot updates a hotspot
+// UpdateHotspot updates a hotspot as is.
+// The territory service's SaveHotspot wraps it so the defense strength is recalculated first.
 func (r *territoryRepository) UpdateHotspot(hotspot *model.Hotspot) error {
-	// Calculate defense strength based on allocated resources
-	hotspot.DefenseStrength = (hotspot.Crew * 10) + (hotspot.Weapons * 15) + (hotspot.Vehicles * 20)
 	return r.db.GetDB().Save(hotspot).Error
 }
 
@@ -407,19 +483,438 @@ func (r *territoryRepository) UpdateHotspotPendingCollection(hotspotID string, a
 		}).Error
 }
 
-// RefreshIllegalBusinesses randomly refreshes illegal businesses
-func (r *territoryRepository) RefreshIllegalBusinesses() error {
-	// In a real implementation, this would randomly change some illegal businesses
-	// For now, we just ensure all illegal businesses have no controller
+// RotateIllegalHotspots retires a city's illegal hotspots and creates their replacements.
+// Hotspots with an assault or garrison transfer under way are kept until it settles.
+// Retired hotspots are soft deleted so their action history stays intact.
+func (r *territoryRepository) RotateIllegalHotspots(cityID string, spawned []model.Hotspot) (int, error) {
+	var retired int64
+	err := r.db.GetDB().Transaction(func(tx *gorm.DB) error {
+		assaulted := tx.Model(&model.Assault{}).
+			Select("hotspot_id").
+			Where("status IN ?", []string{util.AssaultStatusPreparing, util.AssaultStatusResolving})
+		transferring := tx.Model(&model.GarrisonTransfer{}).
+			Select("from_hotspot_id").
+			Where("status = ?", util.GarrisonTransferStatusInTransit)
+		receiving := tx.Model(&model.GarrisonTransfer{}).
+			Select("to_hotspot_id").
+			Where("status = ?", util.GarrisonTransferStatusInTransit)
+
+		result := tx.Where("city_id = ? AND is_legal = ?", cityID, false).
+			Where("id NOT IN (?) AND id NOT IN (?) AND id NOT IN (?)", assaulted, transferring, receiving).
+			Delete(&model.Hotspot{})
+		if result.Error != nil {
+			return result.Error
+		}
+		retired = result.RowsAffected
+
+		for i := range spawned {
+			if err := tx.Create(&spawned[i]).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Model(&model.City{}).Where("id = ?", cityID).Update("last_illegal_rotation", time.Now()).Error
+	})
+
+	return int(retired), err
+}
+
+// GetLastIllegalRotation returns when any city's illegal businesses were last rotated, or nil if they never were
+func (r *territoryRepository) GetLastIllegalRotation() (*time.Time, error) {
+	var last *time.Time
+	if err := r.db.GetDB().Model(&model.City{}).Select("MAX(last_illegal_rotation)").Scan(&last).Error; err != nil {
+		return nil, err
+	}
+	return last, nil
+}
+
+// GetLegalHotspotControlCounts counts legal hotspots per city and controller, with the city's district and region
+func (r *territoryRepository) GetLegalHotspotControlCounts() ([]model.HotspotControlCount, error) {
+	var counts []model.HotspotControlCount
+	if err := r.db.GetDB().
+		Table("hotspots").
+		Select(`hotspots.city_id, cities.name AS city_name,
+			cities.district_id, districts.name AS district_name,
+			districts.region_id, regions.name AS region_name,
+			hotspots.controller_id, players.name AS controller_name, COUNT(*) AS hotspots`).
+		Joins("JOIN cities ON cities.id = hotspots.city_id AND cities.retired_at IS NULL").
+		Joins("JOIN districts ON districts.id = cities.district_id AND districts.retired_at IS NULL").
+		Joins("JOIN regions ON regions.id = districts.region_id AND regions.retired_at IS NULL").
+		Joins("LEFT JOIN players ON players.id = hotspots.controller_id").
+		Where("hotspots.is_legal = ? AND hotspots.retired_at IS NULL", true).
+		Group("hotspots.city_id, cities.name, cities.district_id, districts.name, districts.region_id, regions.name, hotspots.controller_id, players.name").
+		Scan(&counts).Error; err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+// GetHotspotRegionID finds the region a hotspot belongs to
+func (r *territoryRepository) GetHotspotRegionID(hotspotID string) (string, error) {
+	var regionID string
+	if err := r.db.GetDB().
+		Table("hotspots").
+		Select("districts.region_id").
+		Joins("JOIN cities ON cities.id = hotspots.city_id").
+		Joins("JOIN districts ON districts.id = cities.district_id").
+		Where("hotspots.id = ?", hotspotID).
+		Scan(&regionID).Error; err != nil {
+		return "", err
+	}
+
+	if regionID == "" {
+		return "", errors.New("hotspot not found")
+	}
+
+	return regionID, nil
+}
+
+// CountHotspotsLostSince counts the takeovers a player failed to defend since a point in time
+func (r *territoryRepository) CountHotspotsLostSince(playerID string, since time.Time) (int, error) {
+	var count int64
+	if err := r.db.GetDB().Model(&model.TerritoryAction{}).
+		Where("player_id = ? AND type = ? AND success = ? AND timestamp >= ?",
+			playerID, util.TerritoryActionTypeTakeoverDefense, false, since).
+		Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// UpdateHotspotGarrisons saves the garrisons of several hotspots at once
+func (r *territoryRepository) UpdateHotspotGarrisons(hotspots []model.Hotspot) error {
+	return r.db.GetDB().Transaction(func(tx *gorm.DB) error {
+		for _, hotspot := range hotspots {
+			if err := tx.Model(&model.Hotspot{}).
+				Where("id = ?", hotspot.ID).
+				Updates(map[string]interface{}{
+					"crew":             hotspot.Crew,
+					"weapons":          hotspot.Weapons,
+					"vehicles":         hotspot.Vehicles,
+					"defense_strength": hotspot.DefenseStrength,
+				}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// CreateGarrisonTransfer records a garrison setting out for another hotspot
+func (r *territoryRepository) CreateGarrisonTransfer(transfer *model.GarrisonTransfer) error {
+	return r.db.GetDB().Create(transfer).Error
+}
+
+// UpdateGarrisonTransfer updates a garrison transfer
+func (r *territoryRepository) UpdateGarrisonTransfer(transfer *model.GarrisonTransfer) error {
+	return r.db.GetDB().Save(transfer).Error
+}
+
+// GetDueGarrisonTransfers retrieves transfers in transit that should have arrived by now
+func (r *territoryRepository) GetDueGarrisonTransfers(now time.Time) ([]model.GarrisonTransfer, error) {
+	var transfers []model.GarrisonTransfer
+	if err := r.db.GetDB().
+		Where("status = ? AND arrives_at <= ?", util.GarrisonTransferStatusInTransit, now).
+		Order("arrives_at ASC").
+		Find(&transfers).Error; err != nil {
+		return nil, err
+	}
+
+	return transfers, nil
+}
+
+// GetGarrisonTransfersInTransit retrieves a player's transfers that are still on the road
+func (r *territoryRepository) GetGarrisonTransfersInTransit(playerID string) ([]model.GarrisonTransfer, error) {
+	var transfers []model.GarrisonTransfer
+	if err := r.db.GetDB().
+		Where("player_id = ? AND status = ?", playerID, util.GarrisonTransferStatusInTransit).
+		Order("arrives_at ASC").
+		Find(&transfers).Error; err != nil {
+		return nil, err
+	}
+
+	return transfers, nil
+}
+
+// GetHotspotUpgrades retrieves every fortification track a hotspot has started
+func (r *territoryRepository) GetHotspotUpgrades(hotspotID string) ([]model.HotspotUpgrade, error) {
+	var upgrades []model.HotspotUpgrade
+	if err := r.db.GetDB().
+		Where("hotspot_id = ?", hotspotID).
+		Find(&upgrades).Error; err != nil {
+		return nil, err
+	}
+
+	return upgrades, nil
+}
+
+// SaveHotspotUpgrade creates or updates a hotspot's fortification track
+func (r *territoryRepository) SaveHotspotUpgrade(upgrade *model.HotspotUpgrade) error {
+	return r.db.GetDB().Save(upgrade).Error
+}
+
+// StartHotspotUpgrade charges a player for the next level of a fortification track and starts building it.
+// The charge and the construction are committed together, and neither happens if the player
+// cannot pay or another build was started on the track in the meantime.
+func (r *territoryRepository) StartHotspotUpgrade(upgrade *model.HotspotUpgrade, playerID string, cost int) error {
+	return r.db.GetDB().Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.Player{}).
+			Where("id = ? AND money >= ?", playerID, cost).
+			Update("money", gorm.Expr("money - ?", cost))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errors.New("not enough money")
+		}
+
+		// A track seen for the first time gets its row now; the unique index stops a second one
+		if upgrade.ID == "" {
+			return tx.Create(upgrade).Error
+		}
+
+		result = tx.Model(&model.HotspotUpgrade{}).
+			Where("id = ? AND building_to = 0 AND level = ?", upgrade.ID, upgrade.Level).
+			Updates(map[string]interface{}{
+				"building_to":  upgrade.BuildingTo,
+				"completes_at": upgrade.CompletesAt,
+				"updated_at":   time.Now(),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errors.New("an upgrade is already being built on this track")
+		}
+		return nil
+	})
+}
+
+// GetDueHotspotUpgrades retrieves fortification builds that should have finished by now
+func (r *territoryRepository) GetDueHotspotUpgrades(now time.Time) ([]model.HotspotUpgrade, error) {
+	var upgrades []model.HotspotUpgrade
+	if err := r.db.GetDB().
+		Where("building_to > 0 AND completes_at <= ?", now).
+		Order("completes_at ASC").
+		Find(&upgrades).Error; err != nil {
+		return nil, err
+	}
+
+	return upgrades, nil
+}
+
+// ApplyPoliceRaid takes a raid's losses from a hotspot, materializing the income accrued before it.
+// It only touches the raided columns, and reports false when the hotspot changed hands or earned income since it was read.
+func (r *territoryRepository) ApplyPoliceRaid(raid *model.PoliceRaid, accrued int, incomeTimeBefore, incomeTimeAfter *time.Time) (bool, error) {
+	updates := map[string]interface{}{
+		"pending_collection": gorm.Expr("GREATEST(0, pending_collection + ? - ?)", accrued, raid.MoneySeized),
+		"crew":               gorm.Expr("GREATEST(0, crew - ?)", raid.CrewLost),
+		"weapons":            gorm.Expr("GREATEST(0, weapons - ?)", raid.WeaponsLost),
+		"vehicles":           gorm.Expr("GREATEST(0, vehicles - ?)", raid.VehiclesLost),
+		"last_income_time":   incomeTimeAfter,
+		"updated_at":         time.Now(),
+	}
+	if raid.ClosedUntil != nil {
+		updates["closed_until"] = raid.ClosedUntil
+	}
+
+	result := r.db.GetDB().Model(&model.Hotspot{}).
+		Where("id = ? AND controller_id = ?", raid.HotspotID, raid.ControllerID).
+		Where("last_income_time IS NOT DISTINCT FROM ?", incomeTimeBefore).
+		Updates(updates)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// UpdateHotspotDefenseStrength sets a hotspot's defense strength without touching its other columns
+func (r *territoryRepository) UpdateHotspotDefenseStrength(hotspotID string, defenseStrength int) error {
 	return r.db.GetDB().Model(&model.Hotspot{}).
-		Where("is_legal = ?", false).
+		Where("id = ?", hotspotID).
+		Update("defense_strength", defenseStrength).Error
+}
+
+// AddLocalHeat raises the heat of a city and its region, capped at the maximum, and returns the city's new heat
+func (r *territoryRepository) AddLocalHeat(cityID, regionID string, cityAmount, regionAmount, maxHeat int) (int, error) {
+	var heat int
+	err := r.db.GetDB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.City{}).
+			Where("id = ?", cityID).
+			Update("heat", gorm.Expr("LEAST(?, heat + ?)", maxHeat, cityAmount)).Error; err != nil {
+			return err
+		}
+
+		if regionID != "" && regionAmount > 0 {
+			if err := tx.Model(&model.Region{}).
+				Where("id = ?", regionID).
+				Update("heat", gorm.Expr("LEAST(?, heat + ?)", maxHeat, regionAmount)).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Model(&model.City{}).
+			Where("id = ?", cityID).
+			Pluck("heat", &heat).Error
+	})
+	return heat, err
+}
+
+// AddRegionHeat raises the heat of several regions, capped at the maximum
+func (r *territoryRepository) AddRegionHeat(regionIDs []string, amount, maxHeat int) error {
+	return r.db.GetDB().Model(&model.Region{}).
+		Where("id IN ?", regionIDs).
+		Update("heat", gorm.Expr("LEAST(?, heat + ?)", maxHeat, amount)).Error
+}
+
+// DecayLocalHeat cools every city and region down by a fixed amount
+func (r *territoryRepository) DecayLocalHeat(amount int) error {
+	return r.db.GetDB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.City{}).
+			Where("heat > 0").
+			Update("heat", gorm.Expr("GREATEST(0, heat - ?)", amount)).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&model.Region{}).
+			Where("heat > 0").
+			Update("heat", gorm.Expr("GREATEST(0, heat - ?)", amount)).Error
+	})
+}
+
+// StartCityCrackdown starts a crackdown in a city unless one is already running, and reports whether it started
+func (r *territoryRepository) StartCityCrackdown(cityID string, until time.Time, heatRelief int) (bool, error) {
+	result := r.db.GetDB().Model(&model.City{}).
+		Where("id = ? AND (crackdown_until IS NULL OR crackdown_until < ?)", cityID, time.Now()).
 		Updates(map[string]interface{}{
-			"controller_id":    nil,
-			"crew":             0,
-			"weapons":          0,
-			"vehicles":         0,
-			"defense_strength": 0,
+			"crackdown_until": until,
+			"heat":            gorm.Expr("GREATEST(0, heat - ?)", heatRelief),
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// RecordOwnershipChange closes the hotspot's current ownership period and opens one for the new controller, if any
+func (r *territoryRepository) RecordOwnershipChange(hotspotID string, newControllerID *string, how string, at time.Time) error {
+	return r.db.GetDB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.HotspotOwnership{}).
+			Where("hotspot_id = ? AND lost_at IS NULL", hotspotID).
+			Updates(map[string]interface{}{
+				"lost_at":    at,
+				"lost_how":   how,
+				"lost_to_id": newControllerID,
+			}).Error; err != nil {
+			return err
+		}
+
+		if newControllerID == nil {
+			return nil
+		}
+
+		return tx.Create(&model.HotspotOwnership{
+			HotspotID:    hotspotID,
+			ControllerID: *newControllerID,
+			AcquiredAt:   at,
+			AcquiredHow:  how,
 		}).Error
+	})
+}
+
+// GetHotspotOwnership retrieves a hotspot's ownership periods, most recent first
+func (r *territoryRepository) GetHotspotOwnership(hotspotID string) ([]model.HotspotOwnership, error) {
+	var periods []model.HotspotOwnership
+	if err := r.db.GetDB().
+		Where("hotspot_id = ?", hotspotID).
+		Order("acquired_at DESC").
+		Find(&periods).Error; err != nil {
+		return nil, err
+	}
+	return periods, nil
+}
+
+// GetRecentActionsByHotspot retrieves recent territory actions taken against a hotspot.
+// The defender's record of a takeover is left out since it mirrors the attacker's.
+func (r *territoryRepository) GetRecentActionsByHotspot(hotspotID string, limit int) ([]model.TerritoryAction, error) {
+	var actions []model.TerritoryAction
+	if err := r.db.GetDB().
+		Where("hotspot_id = ? AND type <> ?", hotspotID, util.TerritoryActionTypeTakeoverDefense).
+		Order("timestamp DESC").
+		Limit(limit).
+		Find(&actions).Error; err != nil {
+		return nil, err
+	}
+	return actions, nil
+}
+
+// CreateAssault creates a staged assault
+func (r *territoryRepository) CreateAssault(assault *model.Assault) error {
+	return r.db.GetDB().Create(assault).Error
+}
+
+// UpdateAssault updates a staged assault
+func (r *territoryRepository) UpdateAssault(assault *model.Assault) error {
+	return r.db.GetDB().Save(assault).Error
+}
+
+// ClaimAssault moves a preparing assault to resolving, and reports whether this caller got it
+func (r *territoryRepository) ClaimAssault(id string) (bool, error) {
+	result := r.db.GetDB().Model(&model.Assault{}).
+		Where("id = ? AND status = ?", id, util.AssaultStatusPreparing).
+		Update("status", util.AssaultStatusResolving)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// GetDueAssaults retrieves assaults that have finished preparing by the given time
+func (r *territoryRepository) GetDueAssaults(now time.Time) ([]model.Assault, error) {
+	var assaults []model.Assault
+	if err := r.db.GetDB().
+		Where("status = ? AND resolves_at <= ?", util.AssaultStatusPreparing, now).
+		Order("resolves_at ASC").
+		Find(&assaults).Error; err != nil {
+		return nil, err
+	}
+	return assaults, nil
+}
+
+// GetUnspottedAssaults retrieves preparing assaults the defender should have spotted by the given time
+func (r *territoryRepository) GetUnspottedAssaults(now time.Time) ([]model.Assault, error) {
+	var assaults []model.Assault
+	if err := r.db.GetDB().
+		Where("status = ? AND warned_at IS NULL AND warns_at <= ?", util.AssaultStatusPreparing, now).
+		Find(&assaults).Error; err != nil {
+		return nil, err
+	}
+	return assaults, nil
+}
+
+// GetPendingAssaultsByHotspot retrieves the assaults being prepared against a hotspot
+func (r *territoryRepository) GetPendingAssaultsByHotspot(hotspotID string) ([]model.Assault, error) {
+	var assaults []model.Assault
+	if err := r.db.GetDB().
+		Where("hotspot_id = ? AND status = ?", hotspotID, util.AssaultStatusPreparing).
+		Order("resolves_at ASC").
+		Find(&assaults).Error; err != nil {
+		return nil, err
+	}
+	return assaults, nil
+}
+
+// GetPendingAssaultsByPlayer retrieves the preparing assaults a player launched or is defending against
+func (r *territoryRepository) GetPendingAssaultsByPlayer(playerID string) ([]model.Assault, error) {
+	var assaults []model.Assault
+	if err := r.db.GetDB().
+		Where("(attacker_id = ? OR defender_id = ?) AND status = ?", playerID, playerID, util.AssaultStatusPreparing).
+		Order("resolves_at ASC").
+		Find(&assaults).Error; err != nil {
+		return nil, err
+	}
+	return assaults, nil
 }
 
 // GetAllControlledLegalHotspots retrieves all legal hotspots with controllers
@@ -483,3 +978,194 @@ func (r *territoryRepository) GetHotspotsByRegionAndController(regionID, control
 
 	return hotspots, nil
 }
+
+// CreateRegion creates a new region
+func (r *territoryRepository) CreateRegion(region *model.Region) error {
+	return r.db.GetDB().Create(region).Error
+}
+
+// UpdateRegion updates a region's own fields
+func (r *territoryRepository) UpdateRegion(region *model.Region) error {
+	return r.db.GetDB().Model(region).Select("name", "updated_at").Updates(region).Error
+}
+
+// RetireRegion retires a region together with everything inside it
+func (r *territoryRepository) RetireRegion(id string) error {
+	districtIDs := r.db.GetDB().Model(&model.District{}).Select("id").Where("region_id = ?", id)
+	cityIDs := r.db.GetDB().Model(&model.City{}).Select("id").Where("district_id IN (?)", districtIDs)
+
+	return r.db.GetDB().Transaction(func(tx *gorm.DB) error {
+		if err := ensureNoControlledHotspots(tx, cityIDs); err != nil {
+			return err
+		}
+		if err := ensureNoPlayersInRegion(tx, id); err != nil {
+			return err
+		}
+		if err := tx.Where("city_id IN (?)", cityIDs).Delete(&model.Hotspot{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("district_id IN (?)", districtIDs).Delete(&model.City{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("region_id = ?", id).Delete(&model.District{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("from_region_id = ? OR to_region_id = ?", id, id).Delete(&model.RegionRoute{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("id = ?", id).Delete(&model.Region{}).Error
+	})
+}
+
+// GetRegionRoutes retrieves every road between regions
+func (r *territoryRepository) GetRegionRoutes() ([]model.RegionRoute, error) {
+	var routes []model.RegionRoute
+	if err := r.db.GetDB().Find(&routes).Error; err != nil {
+		return nil, err
+	}
+	return routes, nil
+}
+
+// SetRegionRoutes replaces every road touching a region
+func (r *territoryRepository) SetRegionRoutes(regionID string, routes []model.RegionRoute) error {
+	return r.db.GetDB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("from_region_id = ? OR to_region_id = ?", regionID, regionID).Delete(&model.RegionRoute{}).Error; err != nil {
+			return err
+		}
+		if len(routes) == 0 {
+			return nil
+		}
+		return tx.Create(&routes).Error
+	})
+}
+
+// CreateRegionRoutes stores new roads between regions
+func (r *territoryRepository) CreateRegionRoutes(routes []model.RegionRoute) error {
+	if len(routes) == 0 {
+		return nil
+	}
+	return r.db.GetDB().Create(&routes).Error
+}
+
+// CreateDistrict creates a new district
+func (r *territoryRepository) CreateDistrict(district *model.District) error {
+	return r.db.GetDB().Create(district).Error
+}
+
+// UpdateDistrict updates a district's own fields
+func (r *territoryRepository) UpdateDistrict(district *model.District) error {
+	return r.db.GetDB().Model(district).Select("name", "region_id", "updated_at").Updates(district).Error
+}
+
+// RetireDistrict retires a district together with everything inside it
+func (r *territoryRepository) RetireDistrict(id string) error {
+	cityIDs := r.db.GetDB().Model(&model.City{}).Select("id").Where("district_id = ?", id)
+
+	return r.db.GetDB().Transaction(func(tx *gorm.DB) error {
+		if err := ensureNoControlledHotspots(tx, cityIDs); err != nil {
+			return err
+		}
+		if err := tx.Where("city_id IN (?)", cityIDs).Delete(&model.Hotspot{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("district_id = ?", id).Delete(&model.City{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("id = ?", id).Delete(&model.District{}).Error
+	})
+}
+
+// CreateCity creates a new city
+func (r *territoryRepository) CreateCity(city *model.City) error {
+	return r.db.GetDB().Create(city).Error
+}
+
+// UpdateCity updates a city's own fields
+func (r *territoryRepository) UpdateCity(city *model.City) error {
+	return r.db.GetDB().Model(city).Select("name", "district_id", "updated_at").Updates(city).Error
+}
+
+// RetireCity retires a city together with its hotspots
+func (r *territoryRepository) RetireCity(id string) error {
+	return r.db.GetDB().Transaction(func(tx *gorm.DB) error {
+		if err := ensureNoControlledHotspots(tx, []string{id}); err != nil {
+			return err
+		}
+		if err := tx.Where("city_id = ?", id).Delete(&model.Hotspot{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("id = ?", id).Delete(&model.City{}).Error
+	})
+}
+
+// CreateHotspot creates a new hotspot
+func (r *territoryRepository) CreateHotspot(hotspot *model.Hotspot) error {
+	return r.db.GetDB().Create(hotspot).Error
+}
+
+// UpdateHotspotContent updates descriptive hotspot fields without touching ownership or garrison
+func (r *territoryRepository) UpdateHotspotContent(hotspotID string, fields map[string]interface{}) error {
+	fields["updated_at"] = time.Now()
+	result := r.db.GetDB().Model(&model.Hotspot{}).Where("id = ?", hotspotID).Updates(fields)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("hotspot not found")
+	}
+	return nil
+}
+
+// RetireHotspot retires a single hotspot, keeping its action history
+func (r *territoryRepository) RetireHotspot(id string) error {
+	return r.db.GetDB().Transaction(func(tx *gorm.DB) error {
+		var hotspot model.Hotspot
+		if err := tx.Where("id = ?", id).First(&hotspot).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errors.New("hotspot not found")
+			}
+			return err
+		}
+		if hotspot.ControllerID != nil {
+			return errors.New("hotspot is controlled by a player, release it first")
+		}
+		return tx.Delete(&hotspot).Error
+	})
+}
+
+// ensureNoControlledHotspots refuses to retire content that players still control
+func ensureNoControlledHotspots(tx *gorm.DB, cityIDs interface{}) error {
+	var controlled int64
+	if err := tx.Model(&model.Hotspot{}).
+		Where("city_id IN (?) AND controller_id IS NOT NULL", cityIDs).
+		Count(&controlled).Error; err != nil {
+		return err
+	}
+	if controlled > 0 {
+		return errors.New("content contains hotspots controlled by players, release them first")
+	}
+	return nil
+}
+
+// ensureNoPlayersInRegion refuses to retire a region players are standing in or travelling to.
+// Players only track their region, so districts and cities can be retired around them.
+func ensureNoPlayersInRegion(tx *gorm.DB, regionID string) error {
+	var players int64
+	if err := tx.Model(&model.Player{}).
+		Where("current_region_id = ? OR in_transit_to = ?", regionID, regionID).
+		Count(&players).Error; err != nil {
+		return err
+	}
+	if players > 0 {
+		return errors.New("region has players in it or travelling to it, move them first")
+	}
+	return nil
+}
+
+// activeProtection returns when a controller's loss shield ends, or nil if it has already run out
+func activeProtection(player *model.Player) *time.Time {
+	if player.ProtectedUntil == nil || !player.ProtectedUntil.After(time.Now()) {
+		return nil
+	}
+	return player.ProtectedUntil
+}
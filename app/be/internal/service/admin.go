@@ -0,0 +1,571 @@
+// internal/service/admin.go
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"mwce-be/internal/config"
+	"mwce-be/internal/model"
+	"mwce-be/internal/repository"
+	"mwce-be/internal/util"
+
+	"github.com/rs/zerolog"
+)
+
+// AdminService handles back-office business logic
+type AdminService interface {
+	RecordAuditLog(entry *model.AdminAuditLog) error
+	GetAuditLogs(actorID string, limit int) ([]model.AdminAuditLog, error)
+	UpdatePlayerRole(actorID, playerID, role string) (*model.Player, error)
+	BootstrapAdmins(emails []string)
+
+	// Moderation
+	SearchPlayers(query string, limit int) ([]model.Player, error)
+	GetPlayerState(playerID string) (*model.AdminPlayerState, error)
+	SuspendPlayer(actorID, playerID string, request model.SanctionRequest) (*model.Player, error)
+	BanPlayer(actorID, playerID string, request model.SanctionRequest) (*model.Player, error)
+	LiftSanction(actorID, playerID, reason string) (*model.Player, error)
+	AdjustPlayerResources(actorID, playerID string, resources map[string]int, note string) (*model.Player, error)
+	AdjustPlayerResourcesAsOperator(operator, playerID string, resources map[string]int, note string) (*model.Player, error)
+	ResetPlayerResources(actorID, playerID, note string) (*model.Player, error)
+	ReleaseHotspot(actorID, hotspotID, note string) (*model.Hotspot, error)
+	TransferHotspot(actorID, hotspotID, playerID, note string) (*model.Hotspot, error)
+
+	// Operator CLI
+	RecordOperatorCommand(entry *model.OperatorCommandLog) error
+}
+
+type adminService struct {
+	adminRepo        repository.AdminRepository
+	playerRepo       repository.PlayerRepository
+	territoryRepo    repository.TerritoryRepository
+	operationsRepo   repository.OperationsRepository
+	campaignRepo     repository.CampaignRepository
+	territoryService TerritoryService
+	sseService       SSEService
+	gameConfig       config.GameConfig
+	logger           zerolog.Logger
+}
+
+// NewAdminService creates a new admin service
+func NewAdminService(
+	adminRepo repository.AdminRepository,
+	playerRepo repository.PlayerRepository,
+	territoryRepo repository.TerritoryRepository,
+	operationsRepo repository.OperationsRepository,
+	campaignRepo repository.CampaignRepository,
+	territoryService TerritoryService,
+	sseService SSEService,
+	gameConfig config.GameConfig,
+	logger zerolog.Logger,
+) AdminService {
+	return &adminService{
+		adminRepo:        adminRepo,
+		playerRepo:       playerRepo,
+		territoryRepo:    territoryRepo,
+		operationsRepo:   operationsRepo,
+		campaignRepo:     campaignRepo,
+		territoryService: territoryService,
+		sseService:       sseService,
+		gameConfig:       gameConfig,
+		logger:           logger,
+	}
+}
+
+// RecordAuditLog stores an audit log entry for an admin request
+func (s *adminService) RecordAuditLog(entry *model.AdminAuditLog) error {
+	if err := s.adminRepo.CreateAuditLog(entry); err != nil {
+		s.logger.Error().Err(err).Str("actorID", entry.ActorID).Str("path", entry.Path).Msg("Failed to record admin audit log")
+		return err
+	}
+	return nil
+}
+
+// GetAuditLogs retrieves recent audit log entries, optionally for a single actor
+func (s *adminService) GetAuditLogs(actorID string, limit int) ([]model.AdminAuditLog, error) {
+	if limit <= 0 || limit > 500 {
+		limit = 100
+	}
+
+	if actorID != "" {
+		return s.adminRepo.GetAuditLogsByActor(actorID, limit)
+	}
+	return s.adminRepo.GetAuditLogs(limit)
+}
+
+// UpdatePlayerRole changes the access role of a player
+func (s *adminService) UpdatePlayerRole(actorID, playerID, role string) (*model.Player, error) {
+	if !isValidRole(role) {
+		return nil, errors.New("invalid role")
+	}
+
+	// Admins cannot demote themselves, so there is always a way back in
+	if actorID == playerID && role != util.PlayerRoleAdmin {
+		return nil, errors.New("cannot change your own role")
+	}
+
+	if err := s.playerRepo.UpdatePlayerRole(playerID, role); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info().
+		Str("actorID", actorID).
+		Str("playerID", playerID).
+		Str("role", role).
+		Msg("Player role updated")
+
+	return s.playerRepo.GetPlayerByID(playerID)
+}
+
+// BootstrapAdmins promotes the configured accounts to the admin role
+func (s *adminService) BootstrapAdmins(emails []string) {
+	for _, email := range emails {
+		email = strings.TrimSpace(email)
+		if email == "" {
+			continue
+		}
+
+		player, err := s.playerRepo.GetPlayerByEmail(email)
+		if err != nil {
+			s.logger.Warn().Str("email", email).Msg("Bootstrap admin account not found")
+			continue
+		}
+
+		if player.Role == util.PlayerRoleAdmin {
+			continue
+		}
+
+		if err := s.playerRepo.UpdatePlayerRole(player.ID, util.PlayerRoleAdmin); err != nil {
+			s.logger.Error().Err(err).Str("email", email).Msg("Failed to promote bootstrap admin")
+			continue
+		}
+
+		s.logger.Info().Str("email", email).Msg("Promoted bootstrap admin")
+	}
+}
+
+// SearchPlayers finds players by ID, name or email
+func (s *adminService) SearchPlayers(query string, limit int) ([]model.Player, error) {
+	if limit <= 0 || limit > 100 {
+		limit = 25
+	}
+	return s.playerRepo.SearchPlayers(strings.TrimSpace(query), limit)
+}
+
+// GetPlayerState gathers everything support staff need to know about a player
+func (s *adminService) GetPlayerState(playerID string) (*model.AdminPlayerState, error) {
+	player, err := s.playerRepo.GetPlayerByID(playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &model.AdminPlayerState{
+		Player: player,
+	}
+
+	if state.Stats, err = s.playerRepo.GetPlayerStats(playerID); err != nil {
+		s.logger.Warn().Err(err).Str("playerID", playerID).Msg("Failed to get player stats")
+	}
+	if state.Hotspots, err = s.territoryRepo.GetControlledHotspots(playerID); err != nil {
+		return nil, err
+	}
+	if state.ActiveOperations, err = s.operationsRepo.GetCurrentOperations(playerID); err != nil {
+		return nil, err
+	}
+	if state.CampaignProgress, err = s.campaignRepo.GetAllPlayerCampaignProgress(playerID); err != nil {
+		return nil, err
+	}
+	if state.Sanctions, err = s.adminRepo.GetSanctionsByPlayer(playerID); err != nil {
+		return nil, err
+	}
+	if state.Adjustments, err = s.adminRepo.GetResourceAdjustmentsByPlayer(playerID); err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+// SuspendPlayer temporarily locks a player out of the game
+func (s *adminService) SuspendPlayer(actorID, playerID string, request model.SanctionRequest) (*model.Player, error) {
+	if request.ExpiresAt == nil {
+		return nil, errors.New("suspensions require an expiry")
+	}
+	return s.applySanction(actorID, playerID, util.SanctionTypeSuspend, util.AccountStatusSuspended, request)
+}
+
+// BanPlayer locks a player out of the game, permanently unless an expiry is given
+func (s *adminService) BanPlayer(actorID, playerID string, request model.SanctionRequest) (*model.Player, error) {
+	return s.applySanction(actorID, playerID, util.SanctionTypeBan, util.AccountStatusBanned, request)
+}
+
+// LiftSanction restores a suspended or banned player
+func (s *adminService) LiftSanction(actorID, playerID, reason string) (*model.Player, error) {
+	if strings.TrimSpace(reason) == "" {
+		return nil, errors.New("a reason is required")
+	}
+
+	if err := s.checkOutranks(actorID, playerID); err != nil {
+		return nil, err
+	}
+
+	sanction := &model.PlayerSanction{
+		PlayerID:  playerID,
+		ActorID:   actorID,
+		Type:      util.SanctionTypeLift,
+		Reason:    reason,
+		CreatedAt: time.Now(),
+	}
+	if err := s.adminRepo.ApplySanction(sanction, util.AccountStatusActive, "", nil); err != nil {
+		s.logger.Error().Err(err).Str("playerID", playerID).Msg("Failed to lift sanction")
+		return nil, err
+	}
+
+	return s.playerRepo.GetPlayerByID(playerID)
+}
+
+// AdjustPlayerResources applies a manual resource adjustment by a staff member and records it for audit
+func (s *adminService) AdjustPlayerResources(actorID, playerID string, resources map[string]int, note string) (*model.Player, error) {
+	// Moderators can sanction players but not hand out or take away resources
+	actor, err := s.playerRepo.GetPlayerByID(actorID)
+	if err != nil {
+		return nil, errors.New("actor not found")
+	}
+	if roleRank(actor.Role) < roleRank(util.PlayerRoleGameMaster) {
+		return nil, errors.New("only game masters and admins can adjust resources")
+	}
+	if err := s.checkOutranks(actorID, playerID); err != nil {
+		return nil, err
+	}
+
+	return s.adjustPlayerResources(&model.ResourceAdjustment{ActorID: &actorID}, playerID, resources, note)
+}
+
+// AdjustPlayerResourcesAsOperator applies a manual resource adjustment from the operator CLI and records it for audit
+func (s *adminService) AdjustPlayerResourcesAsOperator(operator, playerID string, resources map[string]int, note string) (*model.Player, error) {
+	return s.adjustPlayerResources(&model.ResourceAdjustment{OperatorName: operator}, playerID, resources, note)
+}
+
+// adjustPlayerResources applies a resource adjustment, with the adjustment already naming who made it
+func (s *adminService) adjustPlayerResources(adjustment *model.ResourceAdjustment, playerID string, resources map[string]int, note string) (*model.Player, error) {
+	if strings.TrimSpace(note) == "" {
+		return nil, errors.New("a note is required")
+	}
+	if len(resources) == 0 {
+		return nil, errors.New("no resources to adjust")
+	}
+
+	// Validate resource types before touching anything
+	for resourceType := range resources {
+		if !isAdjustableResource(resourceType) {
+			return nil, fmt.Errorf("invalid resource type: %s", resourceType)
+		}
+	}
+
+	adjustment.PlayerID = playerID
+	adjustment.Money = resources[util.ResourceTypeMoney]
+	adjustment.Crew = resources[util.ResourceTypeCrew]
+	adjustment.Weapons = resources[util.ResourceTypeWeapons]
+	adjustment.Vehicles = resources[util.ResourceTypeVehicles]
+	adjustment.Respect = resources[util.ResourceTypeRespect]
+	adjustment.Influence = resources[util.ResourceTypeInfluence]
+	adjustment.Heat = resources[util.ResourceTypeHeat]
+	adjustment.Note = note
+	adjustment.CreatedAt = time.Now()
+	if err := s.adminRepo.ApplyResourceAdjustment(adjustment); err != nil {
+		s.logger.Error().Err(err).Str("playerID", playerID).Msg("Failed to adjust resources")
+		return nil, err
+	}
+
+	s.logger.Info().
+		Interface("actorID", adjustment.ActorID).
+		Str("operator", adjustment.OperatorName).
+		Str("playerID", playerID).
+		Interface("resources", resources).
+		Msg("Player resources adjusted")
+
+	return s.playerRepo.GetPlayerByID(playerID)
+}
+
+// ResetPlayerResources puts a player's resources back to the starting values
+func (s *adminService) ResetPlayerResources(actorID, playerID, note string) (*model.Player, error) {
+	player, err := s.playerRepo.GetPlayerByID(playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	limits := s.gameConfig.ResourceLimit
+	deltas := map[string]int{
+		util.ResourceTypeMoney:     limits.InitialMoney - player.Money,
+		util.ResourceTypeCrew:      limits.InitialCrew - player.Crew,
+		util.ResourceTypeWeapons:   limits.InitialWeapons - player.Weapons,
+		util.ResourceTypeVehicles:  limits.InitialVehicles - player.Vehicles,
+		util.ResourceTypeRespect:   limits.InitialRespect - player.Respect,
+		util.ResourceTypeInfluence: limits.InitialInfluence - player.Influence,
+		util.ResourceTypeHeat:      limits.InitialHeat - player.Heat,
+	}
+
+	return s.AdjustPlayerResources(actorID, playerID, deltas, "Reset: "+note)
+}
+
+// ReleaseHotspot forces a hotspot back to neutral
+func (s *adminService) ReleaseHotspot(actorID, hotspotID, note string) (*model.Hotspot, error) {
+	if strings.TrimSpace(note) == "" {
+		return nil, errors.New("a note is required")
+	}
+
+	hotspot, err := s.territoryRepo.GetHotspotByID(hotspotID)
+	if err != nil {
+		return nil, err
+	}
+
+	if hotspot.ControllerID == nil {
+		return nil, errors.New("hotspot is not controlled")
+	}
+	previousControllerID := *hotspot.ControllerID
+
+	// Clear control, garrison and pending income
+	hotspot.ControllerID = nil
+	hotspot.ControllerName = nil
+	hotspot.Crew = 0
+	hotspot.Weapons = 0
+	hotspot.Vehicles = 0
+	hotspot.PendingCollection = 0
+	hotspot.LastIncomeTime = nil
+
+	// Fortifications stay with the building, so saving recalculates the defense they alone provide
+	if err := s.territoryService.SaveHotspot(hotspot); err != nil {
+		return nil, err
+	}
+
+	// Close the ownership period
+	if err := s.territoryRepo.RecordOwnershipChange(hotspot.ID, nil, util.OwnershipChangeAdminRelease, time.Now()); err != nil {
+		s.logger.Error().Err(err).Str("hotspotID", hotspot.ID).Msg("Failed to record hotspot ownership change")
+	}
+
+	// Losing the hotspot can cost the previous controller a neighborhood bonus
+	if err := s.territoryService.RefreshPlayerIncome(previousControllerID); err != nil {
+		s.logger.Error().Err(err).Str("playerID", previousControllerID).Msg("Failed to refresh player income")
+	}
+
+	// Let the previous controller know
+	s.playerRepo.AddNotification(&model.Notification{
+		PlayerID:  previousControllerID,
+		Message:   fmt.Sprintf("%s has been released by the administration.", hotspot.Name),
+		Type:      util.NotificationTypeSystem,
+		Timestamp: time.Now(),
+		Read:      false,
+	})
+	s.sseService.SendEventToPlayer(previousControllerID, "hotspot_updated", map[string]interface{}{
+		"hotspot": map[string]interface{}{
+			"id":           hotspot.ID,
+			"name":         hotspot.Name,
+			"controllerID": nil,
+		},
+	})
+
+	s.logger.Info().
+		Str("actorID", actorID).
+		Str("hotspotID", hotspotID).
+		Str("previousControllerID", previousControllerID).
+		Str("note", note).
+		Msg("Hotspot force-released")
+
+	return hotspot, nil
+}
+
+// TransferHotspot hands control of a hotspot to another player, dropping the previous garrison
+func (s *adminService) TransferHotspot(actorID, hotspotID, playerID, note string) (*model.Hotspot, error) {
+	if strings.TrimSpace(note) == "" {
+		return nil, errors.New("a note is required")
+	}
+
+	hotspot, err := s.territoryRepo.GetHotspotByID(hotspotID)
+	if err != nil {
+		return nil, err
+	}
+
+	player, err := s.playerRepo.GetPlayerByID(playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if hotspot.ControllerID != nil && *hotspot.ControllerID == player.ID {
+		return nil, errors.New("player already controls this hotspot")
+	}
+
+	var previousControllerID string
+	if hotspot.ControllerID != nil {
+		previousControllerID = *hotspot.ControllerID
+	}
+
+	// Hand over control with an empty garrison and a fresh income clock
+	now := time.Now()
+	hotspot.ControllerID = &player.ID
+	hotspot.ControllerName = &player.Name
+	hotspot.Crew = 0
+	hotspot.Weapons = 0
+	hotspot.Vehicles = 0
+	hotspot.PendingCollection = 0
+	hotspot.LastIncomeTime = &now
+
+	// Fortifications stay with the building, so saving recalculates the defense they alone provide
+	if err := s.territoryService.SaveHotspot(hotspot); err != nil {
+		return nil, err
+	}
+
+	// Hand the ownership period over
+	if err := s.territoryRepo.RecordOwnershipChange(hotspot.ID, &player.ID, util.OwnershipChangeAdminTransfer, now); err != nil {
+		s.logger.Error().Err(err).Str("hotspotID", hotspot.ID).Msg("Failed to record hotspot ownership change")
+	}
+
+	// Neighborhood bonuses move with the hotspot
+	for _, playerID := range []string{player.ID, previousControllerID} {
+		if playerID == "" {
+			continue
+		}
+		if err := s.territoryService.RefreshPlayerIncome(playerID); err != nil {
+			s.logger.Error().Err(err).Str("playerID", playerID).Msg("Failed to refresh player income")
+		}
+	}
+
+	// Let both sides know
+	if previousControllerID != "" {
+		s.playerRepo.AddNotification(&model.Notification{
+			PlayerID:  previousControllerID,
+			Message:   fmt.Sprintf("%s has been reassigned by the administration.", hotspot.Name),
+			Type:      util.NotificationTypeSystem,
+			Timestamp: now,
+			Read:      false,
+		})
+		s.sseService.SendEventToPlayer(previousControllerID, "hotspot_updated", map[string]interface{}{
+			"hotspot": map[string]interface{}{
+				"id":           hotspot.ID,
+				"name":         hotspot.Name,
+				"controllerID": player.ID,
+			},
+		})
+	}
+
+	s.playerRepo.AddNotification(&model.Notification{
+		PlayerID:  player.ID,
+		Message:   fmt.Sprintf("The administration has handed you control of %s.", hotspot.Name),
+		Type:      util.NotificationTypeSystem,
+		Timestamp: now,
+		Read:      false,
+	})
+	s.sseService.SendEventToPlayer(player.ID, "hotspot_updated", map[string]interface{}{
+		"hotspot": hotspot,
+	})
+
+	s.logger.Info().
+		Str("actorID", actorID).
+		Str("hotspotID", hotspotID).
+		Str("previousControllerID", previousControllerID).
+		Str("playerID", player.ID).
+		Str("note", note).
+		Msg("Hotspot transferred")
+
+	return hotspot, nil
+}
+
+// RecordOperatorCommand stores an audit entry for a command run through the operator CLI
+func (s *adminService) RecordOperatorCommand(entry *model.OperatorCommandLog) error {
+	if err := s.adminRepo.CreateOperatorCommandLog(entry); err != nil {
+		s.logger.Error().Err(err).Str("operator", entry.Operator).Str("command", entry.Command).Msg("Failed to record operator command")
+		return err
+	}
+	return nil
+}
+
+// applySanction sets a restricted account status and records the sanction
+func (s *adminService) applySanction(actorID, playerID, sanctionType, status string, request model.SanctionRequest) (*model.Player, error) {
+	if strings.TrimSpace(request.Reason) == "" {
+		return nil, errors.New("a reason is required")
+	}
+	if request.ExpiresAt != nil && !request.ExpiresAt.After(time.Now()) {
+		return nil, errors.New("expiry must be in the future")
+	}
+	if actorID == playerID {
+		return nil, errors.New("cannot sanction yourself")
+	}
+	if err := s.checkOutranks(actorID, playerID); err != nil {
+		return nil, err
+	}
+
+	sanction := &model.PlayerSanction{
+		PlayerID:  playerID,
+		ActorID:   actorID,
+		Type:      sanctionType,
+		Reason:    request.Reason,
+		ExpiresAt: request.ExpiresAt,
+		CreatedAt: time.Now(),
+	}
+	if err := s.adminRepo.ApplySanction(sanction, status, request.Reason, request.ExpiresAt); err != nil {
+		s.logger.Error().Err(err).Str("playerID", playerID).Msg("Failed to apply sanction")
+		return nil, err
+	}
+
+	// Drop any live connections
+	s.sseService.DisconnectPlayer(playerID, fmt.Sprintf("Your account has been %s: %s", status, request.Reason))
+
+	s.logger.Info().
+		Str("actorID", actorID).
+		Str("playerID", playerID).
+		Str("status", status).
+		Msg("Player sanctioned")
+
+	return s.playerRepo.GetPlayerByID(playerID)
+}
+
+// checkOutranks makes sure staff only act on players below their own role
+func (s *adminService) checkOutranks(actorID, playerID string) error {
+	actor, err := s.playerRepo.GetPlayerByID(actorID)
+	if err != nil {
+		return errors.New("actor not found")
+	}
+	target, err := s.playerRepo.GetPlayerByID(playerID)
+	if err != nil {
+		return errors.New("player not found")
+	}
+
+	if roleRank(actor.Role) <= roleRank(target.Role) {
+		return errors.New("cannot act on a player with an equal or higher role")
+	}
+	return nil
+}
+
+// roleRank orders the player roles from least to most privileged
+func roleRank(role string) int {
+	switch role {
+	case util.PlayerRoleModerator:
+		return 1
+	case util.PlayerRoleGameMaster:
+		return 2
+	case util.PlayerRoleAdmin:
+		return 3
+	}
+	return 0
+}
+
+// isValidRole checks whether a role is one of the known player roles
+func isValidRole(role string) bool {
+	switch role {
+	case util.PlayerRolePlayer, util.PlayerRoleModerator, util.PlayerRoleGameMaster, util.PlayerRoleAdmin:
+		return true
+	}
+	return false
+}
+
+// isAdjustableResource checks whether a resource type can be adjusted by staff
+func isAdjustableResource(resourceType string) bool {
+	switch resourceType {
+	case util.ResourceTypeMoney, util.ResourceTypeCrew, util.ResourceTypeWeapons, util.ResourceTypeVehicles,
+		util.ResourceTypeRespect, util.ResourceTypeInfluence, util.ResourceTypeHeat:
+		return true
+	}
+	return false
+}
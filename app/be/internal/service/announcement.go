@@ -0,0 +1,275 @@
+// internal/service/announcement.go
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"mwce-be/internal/config"
+	"mwce-be/internal/model"
+	"mwce-be/internal/repository"
+	"mwce-be/internal/util"
+
+	"github.com/rs/zerolog"
+)
+
+// AnnouncementService handles game-master announcements
+type AnnouncementService interface {
+	CreateAnnouncement(actorID string, request model.CreateAnnouncementRequest) (*model.Announcement, error)
+	CancelAnnouncement(actorID, announcementID string) (*model.Announcement, error)
+	GetAllAnnouncements(limit int) ([]model.Announcement, error)
+	GetActiveAnnouncements(playerID string) ([]model.Announcement, error)
+	DeliverDueAnnouncements() error
+	StartPeriodicAnnouncementDelivery()
+}
+
+type announcementService struct {
+	announcementRepo repository.AnnouncementRepository
+	playerRepo       repository.PlayerRepository
+	territoryRepo    repository.TerritoryRepository
+	sseService       SSEService
+	gameConfig       config.GameConfig
+	logger           zerolog.Logger
+}
+
+// NewAnnouncementService creates a new announcement service
+func NewAnnouncementService(
+	announcementRepo repository.AnnouncementRepository,
+	playerRepo repository.PlayerRepository,
+	territoryRepo repository.TerritoryRepository,
+	sseService SSEService,
+	gameConfig config.GameConfig,
+	logger zerolog.Logger,
+) AnnouncementService {
+	return &announcementService{
+		announcementRepo: announcementRepo,
+		playerRepo:       playerRepo,
+		territoryRepo:    territoryRepo,
+		sseService:       sseService,
+		gameConfig:       gameConfig,
+		logger:           logger,
+	}
+}
+
+// CreateAnnouncement schedules a new announcement, delivering it right away if it has already started
+func (s *announcementService) CreateAnnouncement(actorID string, request model.CreateAnnouncementRequest) (*model.Announcement, error) {
+	if strings.TrimSpace(request.Title) == "" || strings.TrimSpace(request.Body) == "" {
+		return nil, errors.New("title and body are required")
+	}
+
+	now := time.Now()
+	startsAt := now
+	if request.StartsAt != nil {
+		startsAt = *request.StartsAt
+	}
+	if !request.EndsAt.After(startsAt) || !request.EndsAt.After(now) {
+		return nil, errors.New("end time must be after the start time and in the future")
+	}
+
+	severity := request.Severity
+	if severity == "" {
+		severity = util.AnnouncementSeverityInfo
+	}
+	switch severity {
+	case util.AnnouncementSeverityInfo, util.AnnouncementSeverityWarning, util.AnnouncementSeverityCritical:
+	default:
+		return nil, errors.New("invalid severity")
+	}
+
+	announcement := &model.Announcement{
+		Title:     request.Title,
+		Body:      request.Body,
+		Severity:  severity,
+		Audience:  request.Audience,
+		StartsAt:  startsAt,
+		EndsAt:    request.EndsAt,
+		CreatedBy: actorID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	// Validate the target audience
+	switch request.Audience {
+	case "", util.AnnouncementAudienceAll:
+		announcement.Audience = util.AnnouncementAudienceAll
+	case util.AnnouncementAudienceRegion:
+		if request.TargetRegionID == nil {
+			return nil, errors.New("region announcements require a target region")
+		}
+		if _, err := s.territoryRepo.GetRegionByID(*request.TargetRegionID); err != nil {
+			return nil, err
+		}
+		announcement.TargetRegionID = request.TargetRegionID
+	case util.AnnouncementAudienceTitle:
+		if !isValidTitle(request.TargetTitle) {
+			return nil, errors.New("title announcements require a valid target title")
+		}
+		announcement.TargetTitle = request.TargetTitle
+	default:
+		return nil, errors.New("invalid audience")
+	}
+
+	if err := s.announcementRepo.CreateAnnouncement(announcement); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info().
+		Str("actorID", actorID).
+		Str("announcementID", announcement.ID).
+		Time("startsAt", announcement.StartsAt).
+		Msg("Announcement scheduled")
+
+	// Deliver right away if it is already running, without holding up the request
+	if !announcement.StartsAt.After(now) {
+		delivery := *announcement
+		go s.deliverAnnouncement(&delivery)
+	}
+
+	return announcement, nil
+}
+
+// CancelAnnouncement stops an announcement from being shown or delivered
+func (s *announcementService) CancelAnnouncement(actorID, announcementID string) (*model.Announcement, error) {
+	announcement, err := s.announcementRepo.GetAnnouncementByID(announcementID)
+	if err != nil {
+		return nil, err
+	}
+
+	if announcement.CancelledAt != nil {
+		return nil, errors.New("announcement is already cancelled")
+	}
+
+	now := time.Now()
+	announcement.CancelledAt = &now
+	announcement.UpdatedAt = now
+
+	if err := s.announcementRepo.UpdateAnnouncement(announcement); err != nil {
+		return nil, err
+	}
+
+	// Let connected clients drop it from view
+	s.sseService.SendEventToAll("announcement_cancelled", map[string]interface{}{
+		"id": announcement.ID,
+	})
+
+	s.logger.Info().Str("actorID", actorID).Str("announcementID", announcementID).Msg("Announcement cancelled")
+
+	return announcement, nil
+}
+
+// GetAllAnnouncements retrieves recent announcements for the back office
+func (s *announcementService) GetAllAnnouncements(limit int) ([]model.Announcement, error) {
+	if limit <= 0 || limit > 200 {
+		limit = 50
+	}
+	return s.announcementRepo.GetAnnouncements(limit)
+}
+
+// GetActiveAnnouncements retrieves the running announcements that target a player
+func (s *announcementService) GetActiveAnnouncements(playerID string) ([]model.Announcement, error) {
+	player, err := s.playerRepo.GetPlayerByID(playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	announcements, err := s.announcementRepo.GetActiveAnnouncements(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	targeted := make([]model.Announcement, 0, len(announcements))
+	for _, announcement := range announcements {
+		if s.isTargeted(&announcement, player) {
+			targeted = append(targeted, announcement)
+		}
+	}
+
+	return targeted, nil
+}
+
+// DeliverDueAnnouncements broadcasts announcements whose start time has passed
+func (s *announcementService) DeliverDueAnnouncements() error {
+	announcements, err := s.announcementRepo.GetUndeliveredAnnouncements(time.Now())
+	if err != nil {
+		return err
+	}
+
+	for i := range announcements {
+		s.deliverAnnouncement(&announcements[i])
+	}
+
+	return nil
+}
+
+// deliverAnnouncement persists a system notification for every targeted player and pushes it live.
+// The announcement is marked delivered before the fan-out, so only one caller ever sends it.
+func (s *announcementService) deliverAnnouncement(announcement *model.Announcement) {
+	now := time.Now()
+	claimed, err := s.announcementRepo.MarkAnnouncementDelivered(announcement.ID, now)
+	if err != nil {
+		s.logger.Error().Err(err).Str("announcementID", announcement.ID).Msg("Failed to mark announcement delivered")
+		return
+	}
+	if !claimed {
+		// Already delivered, or cancelled in the meantime
+		return
+	}
+	announcement.DeliveredAt = &now
+	announcement.UpdatedAt = now
+
+	players, err := s.playerRepo.GetAllPlayers()
+	if err != nil {
+		s.logger.Error().Err(err).Str("announcementID", announcement.ID).Msg("Failed to load players for announcement")
+		return
+	}
+
+	message := fmt.Sprintf("%s: %s", announcement.Title, announcement.Body)
+	delivered := 0
+
+	for i := range players {
+		player := &players[i]
+		if !s.isTargeted(announcement, player) {
+			continue
+		}
+
+		// Persist for players who are offline
+		if err := s.playerRepo.AddNotification(&model.Notification{
+			PlayerID:  player.ID,
+			Message:   message,
+			Type:      util.NotificationTypeSystem,
+			Timestamp: now,
+			Read:      false,
+		}); err != nil {
+			s.logger.Error().Err(err).Str("playerID", player.ID).Msg("Failed to add announcement notification")
+			continue
+		}
+
+		// Push to anyone who is connected
+		s.sseService.SendEventToPlayer(player.ID, "announcement", map[string]interface{}{
+			"announcement": announcement,
+		})
+		delivered++
+	}
+
+	s.logger.Info().
+		Str("announcementID", announcement.ID).
+		Int("recipients", delivered).
+		Msg("Announcement delivered")
+}
+
+// isTargeted checks whether an announcement's audience includes a player
+func (s *announcementService) isTargeted(announcement *model.Announcement, player *model.Player) bool {
+	switch announcement.Audience {
+	case util.AnnouncementAudienceRegion:
+		return announcement.TargetRegionID != nil &&
+			player.CurrentRegionID != nil &&
+			*player.CurrentRegionID == *announcement.TargetRegionID
+	case util.AnnouncementAudienceTitle:
+		return meetsMinimumTitle(player.Title, announcement.TargetTitle)
+	default:
+		return true
+	}
+}
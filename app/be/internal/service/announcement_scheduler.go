@@ -0,0 +1,29 @@
+// internal/service/announcement_scheduler.go
+
+package service
+
+import (
+	"time"
+)
+
+// StartPeriodicAnnouncementDelivery starts a goroutine that delivers scheduled announcements
+func (s *announcementService) StartPeriodicAnnouncementDelivery() {
+	deliveryInterval := time.Duration(s.gameConfig.AnnouncementDeliveryInterval) * time.Second
+	if deliveryInterval <= 0 {
+		deliveryInterval = 30 * time.Second // Check twice a minute by default
+	}
+
+	s.logger.Info().
+		Dur("interval", deliveryInterval).
+		Msg("Starting periodic announcement delivery")
+
+	// Start ticker for periodic delivery
+	ticker := time.NewTicker(deliveryInterval)
+	go func() {
+		for range ticker.C {
+			if err := s.DeliverDueAnnouncements(); err != nil {
+				s.logger.Error().Err(err).Msg("Failed to deliver announcements")
+			}
+		}
+	}()
+}
@@ -0,0 +1,339 @@
+// internal/service/assault.go
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"mwce-be/internal/model"
+	"mwce-be/internal/util"
+)
+
+// handleAssault launches a staged takeover that resolves once the crew has finished preparing
+func (s *territoryService) handleAssault(player *model.Player, hotspot *model.Hotspot, resources model.ActionResources) (*model.ActionResult, error) {
+	// Validate the action
+	if !hotspot.IsLegal {
+		return nil, errors.New("cannot assault illegal businesses")
+	}
+
+	if hotspot.ControllerID == nil {
+		return nil, errors.New("nobody holds this business, take it over directly")
+	}
+
+	if *hotspot.ControllerID == player.ID {
+		return nil, errors.New("you already control this business")
+	}
+
+	now := time.Now()
+	if isProtected(hotspot.ProtectedUntil, now) {
+		return nil, fmt.Errorf("%s is under protection until %s", hotspot.Name, hotspot.ProtectedUntil.Format(time.Kitchen))
+	}
+
+	if resources.Crew < 0 || resources.Weapons < 0 || resources.Vehicles < 0 {
+		return nil, errors.New("resource amounts cannot be negative")
+	}
+	if resources.Crew+resources.Weapons+resources.Vehicles == 0 {
+		return nil, errors.New("an assault needs resources committed")
+	}
+
+	pending, err := s.territoryRepo.GetPendingAssaultsByHotspot(hotspot.ID)
+	if err != nil {
+		return nil, errors.New("failed to check pending assaults")
+	}
+	for _, assault := range pending {
+		if assault.AttackerID == player.ID {
+			return nil, errors.New("you are already preparing an assault on this business")
+		}
+	}
+
+	// Attacking another player gives up the attacker's own shield
+	s.endProtection(player)
+
+	// The crew is committed as soon as preparations start
+	resourceUpdates := map[string]int{
+		"crew":     -resources.Crew,
+		"weapons":  -resources.Weapons,
+		"vehicles": -resources.Vehicles,
+	}
+	if err := s.updatePlayerResources(player.ID, resourceUpdates); err != nil {
+		s.logger.Error().Err(err).Msg("Failed to update player resources after launching assault")
+		return nil, errors.New("failed to update player resources")
+	}
+
+	// Lookouts spot the preparations sooner
+	tuning := s.gameConfig.Mechanics.TerritoryActions.Assault
+	spotDelay := tuning.SpotDelay
+	if upgrades, err := s.hotspotUpgradesByTrack(hotspot.ID); err != nil {
+		s.logger.Error().Err(err).Str("hotspotID", hotspot.ID).Msg("Failed to get fortifications for assault")
+	} else {
+		spotDelay = spotDelay * (100 - s.fortificationEffects(upgrades).WarningWindowReduction) / 100
+	}
+
+	assault := &model.Assault{
+		AttackerID:   player.ID,
+		AttackerName: player.Name,
+		HotspotID:    hotspot.ID,
+		HotspotName:  hotspot.Name,
+		DefenderID:   *hotspot.ControllerID,
+		Resources:    resources,
+		Status:       util.AssaultStatusPreparing,
+		LaunchedAt:   now,
+		WarnsAt:      now.Add(time.Duration(spotDelay) * time.Second),
+		ResolvesAt:   now.Add(time.Duration(tuning.PreparationTime) * time.Second),
+	}
+	if err := s.territoryRepo.CreateAssault(assault); err != nil {
+		s.logger.Error().Err(err).Msg("Failed to create assault")
+		return nil, errors.New("failed to launch assault")
+	}
+
+	if spotDelay <= 0 {
+		s.warnDefender(assault)
+	}
+
+	result := &model.ActionResult{
+		Success: true,
+		Message: fmt.Sprintf("Your crew is preparing to hit %s. The assault goes in at %s.",
+			hotspot.Name, assault.ResolvesAt.Format(time.Kitchen)),
+	}
+
+	// Add notification
+	if err := s.addNotification(player.ID, result.Message, util.NotificationTypeTerritory); err != nil {
+		s.logger.Error().Err(err).Msg("Failed to add notification after launching assault")
+	}
+
+	return result, nil
+}
+
+// GetAssaults retrieves the assaults a player is preparing and the spotted ones against their businesses
+func (s *territoryService) GetAssaults(playerID string) (*model.AssaultsResponse, error) {
+	assaults, err := s.territoryRepo.GetPendingAssaultsByPlayer(playerID)
+	if err != nil {
+		return nil, err
+	}
+	s.describeAssaults(assaults)
+
+	response := &model.AssaultsResponse{
+		Outgoing: []model.Assault{},
+		Incoming: []model.Assault{},
+	}
+	for _, assault := range assaults {
+		if assault.AttackerID == playerID {
+			response.Outgoing = append(response.Outgoing, assault)
+		} else if assault.WarnedAt != nil {
+			response.Incoming = append(response.Incoming, assault)
+		}
+	}
+
+	return response, nil
+}
+
+// ProcessAssaults warns defenders of assaults they have spotted and resolves assaults that are ready
+func (s *territoryService) ProcessAssaults() error {
+	now := time.Now()
+
+	unspotted, err := s.territoryRepo.GetUnspottedAssaults(now)
+	if err != nil {
+		return err
+	}
+	s.describeAssaults(unspotted)
+	for i := range unspotted {
+		s.warnDefender(&unspotted[i])
+	}
+
+	due, err := s.territoryRepo.GetDueAssaults(now)
+	if err != nil {
+		return err
+	}
+	for i := range due {
+		s.resolveAssault(&due[i])
+	}
+
+	return nil
+}
+
+// resolveAssault sends the prepared crew in against whatever now defends the hotspot
+func (s *territoryService) resolveAssault(assault *model.Assault) {
+	now := time.Now()
+
+	// Only one resolver gets to refund and resolve an assault
+	claimed, err := s.territoryRepo.ClaimAssault(assault.ID)
+	if err != nil {
+		s.logger.Error().Err(err).Str("assaultID", assault.ID).Msg("Failed to claim assault")
+		return
+	}
+	if !claimed {
+		return
+	}
+
+	// Hand the committed resources back, the takeover deducts them again when the crew goes in
+	committed := map[string]int{
+		"crew":     assault.Resources.Crew,
+		"weapons":  assault.Resources.Weapons,
+		"vehicles": assault.Resources.Vehicles,
+	}
+	if err := s.updatePlayerResources(assault.AttackerID, committed); err != nil {
+		s.logger.Error().Err(err).Str("assaultID", assault.ID).Msg("Failed to return assault resources")
+		s.finishAssault(assault, util.AssaultStatusCalledOff, "The assault was called off.", now)
+		return
+	}
+
+	hotspot, err := s.territoryRepo.GetHotspotByID(assault.HotspotID)
+	if err != nil {
+		s.logger.Error().Err(err).Str("assaultID", assault.ID).Msg("Failed to get hotspot for assault")
+		s.finishAssault(assault, util.AssaultStatusCalledOff, "The assault was called off, the target is gone. Your crew is back.", now)
+		return
+	}
+	assault.HotspotName = hotspot.Name
+
+	attacker, err := s.playerRepo.GetPlayerByID(assault.AttackerID)
+	if err != nil {
+		s.logger.Error().Err(err).Str("assaultID", assault.ID).Msg("Failed to get attacker for assault")
+		s.finishAssault(assault, util.AssaultStatusCalledOff, fmt.Sprintf("The assault on %s was called off.", hotspot.Name), now)
+		return
+	}
+
+	// Nobody leads the crew in while the boss is locked up or on the road
+	if err := checkPlayerCanAct(attacker); err != nil {
+		s.finishAssault(assault, util.AssaultStatusCalledOff,
+			fmt.Sprintf("The assault on %s was called off (%s). Your crew is back.", hotspot.Name, err.Error()), now)
+		return
+	}
+
+	// Income earned so far is materialized when the takeover saves the hotspot
+	accrueIncome(hotspot, now)
+
+	result, err := s.handleTakeover(attacker, hotspot, assault.Resources)
+	if err != nil {
+		// The target changed hands or came under protection while the crew prepared
+		s.finishAssault(assault, util.AssaultStatusCalledOff,
+			fmt.Sprintf("The assault on %s was called off (%s). Your crew is back.", hotspot.Name, err.Error()), now)
+		return
+	}
+
+	// Record the takeover like one launched directly
+	defenderID := assault.DefenderID
+	action := &model.TerritoryAction{
+		Type:       util.TerritoryActionTypeTakeover,
+		PlayerID:   attacker.ID,
+		HotspotID:  hotspot.ID,
+		OpponentID: &defenderID,
+		Resources:  assault.Resources,
+		Result:     result,
+		Timestamp:  now,
+		CreatedAt:  now,
+	}
+	if err := s.territoryRepo.AddTerritoryAction(action); err != nil {
+		s.logger.Error().Err(err).Msg("Failed to record assault takeover")
+	}
+
+	recordPityOutcome(s.playerRepo, s.logger, attacker.ID, util.PityCategoryTakeover, result.Success)
+	s.addLocalHeat(hotspot, result.HeatGenerated)
+
+	status := util.AssaultStatusFailed
+	if result.Success {
+		status = util.AssaultStatusSucceeded
+	}
+	s.finishAssault(assault, status, result.Message, now)
+}
+
+// finishAssault records how an assault ended and tells the attacker, and the defender if they saw it coming.
+// Assaults that were called off also notify the attacker, a resolved takeover already did.
+func (s *territoryService) finishAssault(assault *model.Assault, status, message string, now time.Time) {
+	assault.Status = status
+	assault.ResultMessage = message
+	assault.ResolvedAt = &now
+	if err := s.territoryRepo.UpdateAssault(assault); err != nil {
+		s.logger.Error().Err(err).Str("assaultID", assault.ID).Msg("Failed to update assault")
+	}
+
+	if status == util.AssaultStatusCalledOff {
+		if err := s.addNotification(assault.AttackerID, message, util.NotificationTypeTerritory); err != nil {
+			s.logger.Error().Err(err).Msg("Failed to add notification after calling off assault")
+		}
+	}
+
+	payload := map[string]interface{}{
+		"assaultId":   assault.ID,
+		"hotspotId":   assault.HotspotID,
+		"hotspotName": assault.HotspotName,
+		"status":      assault.Status,
+		"message":     assault.ResultMessage,
+		"timestamp":   now.Format(time.RFC3339),
+	}
+	s.sseService.SendEventToPlayer(assault.AttackerID, "assault_resolved", payload)
+	if assault.WarnedAt != nil {
+		s.sseService.SendEventToPlayer(assault.DefenderID, "assault_resolved", payload)
+	}
+}
+
+// warnDefender tells the defender an assault on their business is being prepared
+func (s *territoryService) warnDefender(assault *model.Assault) {
+	now := time.Now()
+	assault.WarnedAt = &now
+	if err := s.territoryRepo.UpdateAssault(assault); err != nil {
+		s.logger.Error().Err(err).Str("assaultID", assault.ID).Msg("Failed to mark assault as spotted")
+		return
+	}
+
+	message := fmt.Sprintf("%s is preparing to hit %s! Reinforce it before %s.",
+		assault.AttackerName, assault.HotspotName, assault.ResolvesAt.Format(time.Kitchen))
+	if err := s.addNotification(assault.DefenderID, message, util.NotificationTypeTerritory); err != nil {
+		s.logger.Error().Err(err).Msg("Failed to add territory under threat notification")
+	}
+
+	s.sseService.SendEventToPlayer(assault.DefenderID, "territory_under_threat", map[string]interface{}{
+		"assaultId":    assault.ID,
+		"hotspotId":    assault.HotspotID,
+		"hotspotName":  assault.HotspotName,
+		"attackerId":   assault.AttackerID,
+		"attackerName": assault.AttackerName,
+		"resolvesAt":   assault.ResolvesAt,
+		"message":      message,
+		"timestamp":    now.Format(time.RFC3339),
+	})
+}
+
+// describeAssaults fills in the attacker and hotspot names of a list of assaults
+func (s *territoryService) describeAssaults(assaults []model.Assault) {
+	attackers := make(map[string]string)
+	hotspots := make(map[string]string)
+
+	for i := range assaults {
+		assault := &assaults[i]
+
+		if name, cached := attackers[assault.AttackerID]; cached {
+			assault.AttackerName = name
+		} else if attacker, err := s.playerRepo.GetPlayerByID(assault.AttackerID); err == nil {
+			attackers[assault.AttackerID] = attacker.Name
+			assault.AttackerName = attacker.Name
+		}
+
+		if name, cached := hotspots[assault.HotspotID]; cached {
+			assault.HotspotName = name
+		} else if hotspot, err := s.territoryRepo.GetHotspotByID(assault.HotspotID); err == nil {
+			hotspots[assault.HotspotID] = hotspot.Name
+			assault.HotspotName = hotspot.Name
+		}
+	}
+}
+
+// spottedAssaults returns the assaults being prepared against a hotspot that the defender has spotted
+func (s *territoryService) spottedAssaults(hotspot *model.Hotspot) []model.Assault {
+	assaults, err := s.territoryRepo.GetPendingAssaultsByHotspot(hotspot.ID)
+	if err != nil {
+		s.logger.Error().Err(err).Str("hotspotID", hotspot.ID).Msg("Failed to get pending assaults")
+		return nil
+	}
+
+	spotted := make([]model.Assault, 0, len(assaults))
+	for _, assault := range assaults {
+		if assault.WarnedAt != nil {
+			spotted = append(spotted, assault)
+		}
+	}
+	s.describeAssaults(spotted)
+
+	return spotted
+}
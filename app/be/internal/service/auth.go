@@ -15,11 +15,17 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
+// Errors returned when a restricted account tries to sign in or use a token
+var (
+	ErrAccountSuspended = errors.New("account suspended")
+	ErrAccountBanned    = errors.New("account banned")
+)
+
 // AuthService handles authentication-related business logic
 type AuthService interface {
 	Register(request model.RegisterRequest) (*model.AuthResponse, error)
 	Login(request model.LoginRequest) (*model.AuthResponse, error)
-	ValidateToken(token string) (string, error)
+	ValidateToken(token string) (*util.Claims, error)
 }
 
 type authService struct {
@@ -73,7 +79,7 @@ func (s *authService) Register(request model.RegisterRequest) (*model.AuthRespon
 	}
 
 	// Generate JWT token
-	token, err := util.GenerateToken(player.ID, s.jwtConfig.Secret, s.jwtConfig.TokenLifetime)
+	token, err := util.GenerateToken(player.ID, player.Role, s.jwtConfig.Secret, s.jwtConfig.TokenLifetime)
 	if err != nil {
 		return nil, errors.New("failed to generate token")
 	}
@@ -97,6 +103,11 @@ func (s *authService) Login(request model.LoginRequest) (*model.AuthResponse, er
 		return nil, errors.New("invalid email or password")
 	}
 
+	// Reject suspended and banned accounts
+	if err := s.checkAccountStatus(player); err != nil {
+		return nil, err
+	}
+
 	// Update last active timestamp
 	player.LastActive = time.Now()
 	if err := s.playerRepo.UpdatePlayer(player); err != nil {
@@ -104,7 +115,7 @@ func (s *authService) Login(request model.LoginRequest) (*model.AuthResponse, er
 	}
 
 	// Generate JWT token
-	token, err := util.GenerateToken(player.ID, s.jwtConfig.Secret, s.jwtConfig.TokenLifetime)
+	token, err := util.GenerateToken(player.ID, player.Role, s.jwtConfig.Secret, s.jwtConfig.TokenLifetime)
 	if err != nil {
 		return nil, errors.New("failed to generate token")
 	}
@@ -115,22 +126,53 @@ func (s *authService) Login(request model.LoginRequest) (*model.AuthResponse, er
 	}, nil
 }
 
-// ValidateToken validates a JWT token and returns the user ID
-func (s *authService) ValidateToken(token string) (string, error) {
+// ValidateToken validates a JWT token and returns its claims
+func (s *authService) ValidateToken(token string) (*util.Claims, error) {
 	// Parse and validate token
 	claims, err := util.ParseToken(token, s.jwtConfig.Secret)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	// Get the user ID from the claims
-	userID := claims.UserID
-
 	// Verify that the user exists
-	_, err = s.playerRepo.GetPlayerByID(userID)
+	player, err := s.playerRepo.GetPlayerByID(claims.UserID)
 	if err != nil {
-		return "", errors.New("invalid token: user not found")
+		return nil, errors.New("invalid token: user not found")
+	}
+
+	// Reject suspended and banned accounts
+	if err := s.checkAccountStatus(player); err != nil {
+		return nil, err
+	}
+
+	// Use the stored role so that promotions and demotions apply immediately
+	claims.Role = player.Role
+	if claims.Role == "" {
+		claims.Role = util.PlayerRolePlayer
+	}
+
+	return claims, nil
+}
+
+// checkAccountStatus rejects restricted accounts and lifts restrictions that have expired
+func (s *authService) checkAccountStatus(player *model.Player) error {
+	if player.AccountStatus == "" || player.AccountStatus == util.AccountStatusActive {
+		return nil
+	}
+
+	// Lift the restriction once it has run out
+	if player.StatusExpiresAt != nil && time.Now().After(*player.StatusExpiresAt) {
+		if err := s.playerRepo.UpdatePlayerStatus(player.ID, util.AccountStatusActive, "", nil); err != nil {
+			s.logger.Error().Err(err).Str("playerID", player.ID).Msg("Failed to lift expired account restriction")
+		}
+		player.AccountStatus = util.AccountStatusActive
+		player.StatusReason = ""
+		player.StatusExpiresAt = nil
+		return nil
 	}
 
-	return userID, nil
+	if player.AccountStatus == util.AccountStatusBanned {
+		return ErrAccountBanned
+	}
+	return ErrAccountSuspended
 }
@@ -0,0 +1,704 @@
+// internal/service/content.go
+
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"mwce-be/internal/model"
+	"mwce-be/internal/repository"
+	"mwce-be/internal/util"
+	"mwce-be/pkg/database"
+
+	"github.com/rs/zerolog"
+)
+
+// ContentService handles live-ops content management
+type ContentService interface {
+	// Territory content
+	CreateRegion(actorID string, request model.RegionContentRequest) (*model.Region, error)
+	UpdateRegion(actorID, regionID string, request model.RegionContentRequest) (*model.Region, error)
+	RetireRegion(actorID, regionID string) error
+	CreateDistrict(actorID string, request model.DistrictContentRequest) (*model.District, error)
+	UpdateDistrict(actorID, districtID string, request model.DistrictContentRequest) (*model.District, error)
+	RetireDistrict(actorID, districtID string) error
+	CreateCity(actorID string, request model.CityContentRequest) (*model.City, error)
+	UpdateCity(actorID, cityID string, request model.CityContentRequest) (*model.City, error)
+	RetireCity(actorID, cityID string) error
+	CreateHotspot(actorID string, request model.HotspotContentRequest) (*model.Hotspot, error)
+	UpdateHotspot(actorID, hotspotID string, request model.HotspotContentRequest) (*model.Hotspot, error)
+	RetireHotspot(actorID, hotspotID string) error
+
+	// Operations pool
+	GetOperationTemplates() ([]model.OperationTemplate, error)
+	CreateOperationTemplate(actorID string, template model.OperationTemplate) (*model.OperationTemplate, error)
+	UpdateOperationTemplate(actorID, templateID string, template model.OperationTemplate) (*model.OperationTemplate, error)
+	RetireOperationTemplate(actorID, templateID string) error
+
+	// Campaigns
+	PublishCampaign(actorID string, campaign model.Campaign) (*model.Campaign, error)
+	UpdateCampaign(actorID, campaignID string, request model.CampaignContentRequest) (*model.Campaign, error)
+	PublishChapter(actorID, campaignID string, chapter model.Chapter) (*model.Chapter, error)
+
+	// Versions
+	GetContentVersions(entityType, entityID string, limit int) ([]model.ContentVersion, error)
+}
+
+// ErrOperationTemplateNotFound is returned when editing a template that is neither stored nor in operations.yaml
+var ErrOperationTemplateNotFound = errors.New("operation template not found")
+
+type contentService struct {
+	db             database.Database
+	contentRepo    repository.ContentRepository
+	territoryRepo  repository.TerritoryRepository
+	operationsRepo repository.OperationsRepository
+	campaignRepo   repository.CampaignRepository
+	logger         zerolog.Logger
+}
+
+// NewContentService creates a new content service
+func NewContentService(
+	db database.Database,
+	contentRepo repository.ContentRepository,
+	territoryRepo repository.TerritoryRepository,
+	operationsRepo repository.OperationsRepository,
+	campaignRepo repository.CampaignRepository,
+	logger zerolog.Logger,
+) ContentService {
+	return &contentService{
+		db:             db,
+		contentRepo:    contentRepo,
+		territoryRepo:  territoryRepo,
+		operationsRepo: operationsRepo,
+		campaignRepo:   campaignRepo,
+		logger:         logger,
+	}
+}
+
+// CreateRegion creates a new region
+func (s *contentService) CreateRegion(actorID string, request model.RegionContentRequest) (*model.Region, error) {
+	if strings.TrimSpace(request.Name) == "" {
+		return nil, errors.New("name is required")
+	}
+
+	region := &model.Region{Name: request.Name}
+	if err := s.inTransaction(func(tx *contentService) error {
+		if err := tx.territoryRepo.CreateRegion(region); err != nil {
+			return err
+		}
+		if err := tx.setRegionRoutes(region, request.Routes); err != nil {
+			return err
+		}
+		return tx.recordVersion(actorID, util.ContentTypeRegion, region.ID, util.ContentActionCreate, region)
+	}); err != nil {
+		return nil, err
+	}
+	return region, nil
+}
+
+// UpdateRegion updates a region
+func (s *contentService) UpdateRegion(actorID, regionID string, request model.RegionContentRequest) (*model.Region, error) {
+	region, err := s.territoryRepo.GetRegionByID(regionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Name != "" {
+		region.Name = request.Name
+	}
+	region.UpdatedAt = time.Now()
+
+	if err := s.inTransaction(func(tx *contentService) error {
+		if err := tx.territoryRepo.UpdateRegion(region); err != nil {
+			return err
+		}
+		if err := tx.setRegionRoutes(region, request.Routes); err != nil {
+			return err
+		}
+		return tx.recordVersion(actorID, util.ContentTypeRegion, region.ID, util.ContentActionUpdate, region)
+	}); err != nil {
+		return nil, err
+	}
+	return region, nil
+}
+
+// setRegionRoutes replaces the roads between a region and its neighbors, when the request sets them.
+// Travel reads the roads on every trip, so the region is reachable as soon as the change commits.
+func (s *contentService) setRegionRoutes(region *model.Region, requested *[]model.RegionRouteContentData) error {
+	if requested == nil {
+		return nil
+	}
+
+	now := time.Now()
+	routes := make([]model.RegionRoute, 0, len(*requested))
+	for _, route := range *requested {
+		if route.RegionID == region.ID {
+			return errors.New("a region cannot have a road to itself")
+		}
+		if _, err := s.territoryRepo.GetRegionByID(route.RegionID); err != nil {
+			return err
+		}
+		if route.Distance <= 0 {
+			return errors.New("route distance must be positive")
+		}
+
+		routes = append(routes, model.RegionRoute{
+			FromRegionID: region.ID,
+			ToRegionID:   route.RegionID,
+			Distance:     route.Distance,
+			CreatedAt:    now,
+		})
+	}
+
+	if err := s.territoryRepo.SetRegionRoutes(region.ID, routes); err != nil {
+		return err
+	}
+	region.Routes = routes
+	return nil
+}
+
+// RetireRegion retires a region and everything inside it
+func (s *contentService) RetireRegion(actorID, regionID string) error {
+	region, err := s.territoryRepo.GetRegionByID(regionID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.inTransaction(func(tx *contentService) error {
+		if err := tx.territoryRepo.RetireRegion(regionID); err != nil {
+			return err
+		}
+		return tx.recordVersion(actorID, util.ContentTypeRegion, region.ID, util.ContentActionRetire, region)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// CreateDistrict creates a new district
+func (s *contentService) CreateDistrict(actorID string, request model.DistrictContentRequest) (*model.District, error) {
+	if strings.TrimSpace(request.Name) == "" {
+		return nil, errors.New("name is required")
+	}
+	if _, err := s.territoryRepo.GetRegionByID(request.RegionID); err != nil {
+		return nil, err
+	}
+
+	district := &model.District{Name: request.Name, RegionID: request.RegionID}
+	if err := s.inTransaction(func(tx *contentService) error {
+		if err := tx.territoryRepo.CreateDistrict(district); err != nil {
+			return err
+		}
+		return tx.recordVersion(actorID, util.ContentTypeDistrict, district.ID, util.ContentActionCreate, district)
+	}); err != nil {
+		return nil, err
+	}
+	return district, nil
+}
+
+// UpdateDistrict updates a district
+func (s *contentService) UpdateDistrict(actorID, districtID string, request model.DistrictContentRequest) (*model.District, error) {
+	district, err := s.territoryRepo.GetDistrictByID(districtID)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Name != "" {
+		district.Name = request.Name
+	}
+	if request.RegionID != "" && request.RegionID != district.RegionID {
+		if _, err := s.territoryRepo.GetRegionByID(request.RegionID); err != nil {
+			return nil, err
+		}
+		district.RegionID = request.RegionID
+	}
+	district.UpdatedAt = time.Now()
+
+	if err := s.inTransaction(func(tx *contentService) error {
+		if err := tx.territoryRepo.UpdateDistrict(district); err != nil {
+			return err
+		}
+		return tx.recordVersion(actorID, util.ContentTypeDistrict, district.ID, util.ContentActionUpdate, district)
+	}); err != nil {
+		return nil, err
+	}
+	return district, nil
+}
+
+// RetireDistrict retires a district and everything inside it
+func (s *contentService) RetireDistrict(actorID, districtID string) error {
+	district, err := s.territoryRepo.GetDistrictByID(districtID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.inTransaction(func(tx *contentService) error {
+		if err := tx.territoryRepo.RetireDistrict(districtID); err != nil {
+			return err
+		}
+		return tx.recordVersion(actorID, util.ContentTypeDistrict, district.ID, util.ContentActionRetire, district)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// CreateCity creates a new city
+func (s *contentService) CreateCity(actorID string, request model.CityContentRequest) (*model.City, error) {
+	if strings.TrimSpace(request.Name) == "" {
+		return nil, errors.New("name is required")
+	}
+	if _, err := s.territoryRepo.GetDistrictByID(request.DistrictID); err != nil {
+		return nil, err
+	}
+
+	city := &model.City{Name: request.Name, DistrictID: request.DistrictID}
+	if err := s.inTransaction(func(tx *contentService) error {
+		if err := tx.territoryRepo.CreateCity(city); err != nil {
+			return err
+		}
+		return tx.recordVersion(actorID, util.ContentTypeCity, city.ID, util.ContentActionCreate, city)
+	}); err != nil {
+		return nil, err
+	}
+	return city, nil
+}
+
+// UpdateCity updates a city
+func (s *contentService) UpdateCity(actorID, cityID string, request model.CityContentRequest) (*model.City, error) {
+	city, err := s.territoryRepo.GetCityByID(cityID)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Name != "" {
+		city.Name = request.Name
+	}
+	if request.DistrictID != "" && request.DistrictID != city.DistrictID {
+		if _, err := s.territoryRepo.GetDistrictByID(request.DistrictID); err != nil {
+			return nil, err
+		}
+		city.DistrictID = request.DistrictID
+	}
+	city.UpdatedAt = time.Now()
+
+	if err := s.inTransaction(func(tx *contentService) error {
+		if err := tx.territoryRepo.UpdateCity(city); err != nil {
+			return err
+		}
+		return tx.recordVersion(actorID, util.ContentTypeCity, city.ID, util.ContentActionUpdate, city)
+	}); err != nil {
+		return nil, err
+	}
+	return city, nil
+}
+
+// RetireCity retires a city and its hotspots
+func (s *contentService) RetireCity(actorID, cityID string) error {
+	city, err := s.territoryRepo.GetCityByID(cityID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.inTransaction(func(tx *contentService) error {
+		if err := tx.territoryRepo.RetireCity(cityID); err != nil {
+			return err
+		}
+		return tx.recordVersion(actorID, util.ContentTypeCity, city.ID, util.ContentActionRetire, city)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// CreateHotspot creates a new neutral hotspot
+func (s *contentService) CreateHotspot(actorID string, request model.HotspotContentRequest) (*model.Hotspot, error) {
+	if strings.TrimSpace(request.Name) == "" || request.Type == "" || request.BusinessType == "" {
+		return nil, errors.New("name, type and business type are required")
+	}
+	if _, err := s.territoryRepo.GetCityByID(request.CityID); err != nil {
+		return nil, err
+	}
+
+	hotspot := &model.Hotspot{
+		Name:         request.Name,
+		CityID:       request.CityID,
+		Type:         request.Type,
+		BusinessType: request.BusinessType,
+		IsLegal:      request.IsLegal == nil || *request.IsLegal,
+	}
+	if request.Income != nil {
+		hotspot.Income = *request.Income
+	}
+
+	if err := s.inTransaction(func(tx *contentService) error {
+		if err := tx.territoryRepo.CreateHotspot(hotspot); err != nil {
+			return err
+		}
+		return tx.recordVersion(actorID, util.ContentTypeHotspot, hotspot.ID, util.ContentActionCreate, hotspot)
+	}); err != nil {
+		return nil, err
+	}
+	return hotspot, nil
+}
+
+// UpdateHotspot updates the descriptive fields of a hotspot, leaving ownership untouched
+func (s *contentService) UpdateHotspot(actorID, hotspotID string, request model.HotspotContentRequest) (*model.Hotspot, error) {
+	if _, err := s.territoryRepo.GetHotspotByID(hotspotID); err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]interface{})
+	if request.Name != "" {
+		fields["name"] = request.Name
+	}
+	if request.CityID != "" {
+		if _, err := s.territoryRepo.GetCityByID(request.CityID); err != nil {
+			return nil, err
+		}
+		fields["city_id"] = request.CityID
+	}
+	if request.Type != "" {
+		fields["type"] = request.Type
+	}
+	if request.BusinessType != "" {
+		fields["business_type"] = request.BusinessType
+	}
+	if request.IsLegal != nil {
+		fields["is_legal"] = *request.IsLegal
+	}
+	if request.Income != nil {
+		if *request.Income < 0 {
+			return nil, errors.New("income cannot be negative")
+		}
+		fields["income"] = *request.Income
+	}
+
+	if len(fields) == 0 {
+		return nil, errors.New("nothing to update")
+	}
+
+	var hotspot *model.Hotspot
+	if err := s.inTransaction(func(tx *contentService) error {
+		if err := tx.territoryRepo.UpdateHotspotContent(hotspotID, fields); err != nil {
+			return err
+		}
+
+		updated, err := tx.territoryRepo.GetHotspotByID(hotspotID)
+		if err != nil {
+			return err
+		}
+		hotspot = updated
+
+		return tx.recordVersion(actorID, util.ContentTypeHotspot, hotspot.ID, util.ContentActionUpdate, hotspot)
+	}); err != nil {
+		return nil, err
+	}
+	return hotspot, nil
+}
+
+// RetireHotspot retires a neutral hotspot
+func (s *contentService) RetireHotspot(actorID, hotspotID string) error {
+	hotspot, err := s.territoryRepo.GetHotspotByID(hotspotID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.inTransaction(func(tx *contentService) error {
+		if err := tx.territoryRepo.RetireHotspot(hotspotID); err != nil {
+			return err
+		}
+		return tx.recordVersion(actorID, util.ContentTypeHotspot, hotspot.ID, util.ContentActionRetire, hotspot)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetOperationTemplates returns the effective operations pool, including retired admin templates
+func (s *contentService) GetOperationTemplates() ([]model.OperationTemplate, error) {
+	operationsData, err := loadOperationsFromYAML()
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err := s.operationsRepo.GetOperationTemplates()
+	if err != nil {
+		return nil, err
+	}
+
+	storedByID := make(map[string]model.OperationTemplate, len(stored))
+	for _, template := range stored {
+		template.Source = "admin"
+		storedByID[template.ID] = template
+	}
+
+	templates := make([]model.OperationTemplate, 0, len(operationsData.BasicOperations)+len(operationsData.SpecialOperations)+len(stored))
+	for _, yamlTemplate := range append(operationsData.BasicOperations, operationsData.SpecialOperations...) {
+		if template, exists := storedByID[yamlTemplate.ID]; exists {
+			templates = append(templates, template)
+			delete(storedByID, yamlTemplate.ID)
+			continue
+		}
+
+		template := yamlTemplate.ToModel()
+		template.Source = "yaml"
+		templates = append(templates, template)
+	}
+
+	for _, template := range stored {
+		if remaining, exists := storedByID[template.ID]; exists {
+			templates = append(templates, remaining)
+		}
+	}
+
+	return templates, nil
+}
+
+// CreateOperationTemplate adds a new operation template to the pool
+func (s *contentService) CreateOperationTemplate(actorID string, template model.OperationTemplate) (*model.OperationTemplate, error) {
+	template.ID = ""
+	template.CreatedAt = time.Now()
+	return s.saveOperationTemplate(actorID, template, util.ContentActionCreate)
+}
+
+// UpdateOperationTemplate edits a stored template, or overrides one from operations.yaml
+func (s *contentService) UpdateOperationTemplate(actorID, templateID string, template model.OperationTemplate) (*model.OperationTemplate, error) {
+	template.ID = templateID
+	template.CreatedAt = time.Now()
+
+	if existing, err := s.operationsRepo.GetOperationTemplateByID(templateID); err == nil {
+		template.CreatedAt = existing.CreatedAt
+	} else if !s.isYAMLTemplate(templateID) {
+		return nil, ErrOperationTemplateNotFound
+	}
+
+	return s.saveOperationTemplate(actorID, template, util.ContentActionUpdate)
+}
+
+// saveOperationTemplate validates and stores an operation template
+func (s *contentService) saveOperationTemplate(actorID string, template model.OperationTemplate, action string) (*model.OperationTemplate, error) {
+	if strings.TrimSpace(template.Name) == "" || template.Type == "" {
+		return nil, errors.New("name and type are required")
+	}
+	if template.Duration <= 0 {
+		return nil, errors.New("duration must be positive")
+	}
+	if template.SuccessRate < 0 || template.SuccessRate > 100 {
+		return nil, errors.New("success rate must be between 0 and 100")
+	}
+
+	template.UpdatedAt = time.Now()
+	template.IsRetired = false
+
+	if err := s.inTransaction(func(tx *contentService) error {
+		if err := tx.operationsRepo.SaveOperationTemplate(&template); err != nil {
+			return err
+		}
+		template.Source = "admin"
+		return tx.recordVersion(actorID, util.ContentTypeOperationTemplate, template.ID, action, template)
+	}); err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
+
+// RetireOperationTemplate removes a template from the pool; live operations run their course
+func (s *contentService) RetireOperationTemplate(actorID, templateID string) error {
+	template, err := s.operationsRepo.GetOperationTemplateByID(templateID)
+	if err != nil {
+		// Retiring a YAML template creates a retired override for it
+		yamlTemplate, found := s.findYAMLTemplate(templateID)
+		if !found {
+			return errors.New("operation template not found")
+		}
+		converted := yamlTemplate.ToModel()
+		converted.CreatedAt = time.Now()
+		template = &converted
+	}
+
+	template.IsRetired = true
+	template.UpdatedAt = time.Now()
+
+	if err := s.inTransaction(func(tx *contentService) error {
+		if err := tx.operationsRepo.SaveOperationTemplate(template); err != nil {
+			return err
+		}
+		return tx.recordVersion(actorID, util.ContentTypeOperationTemplate, template.ID, util.ContentActionRetire, template)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PublishCampaign creates a new campaign together with its chapters, missions and branches
+func (s *contentService) PublishCampaign(actorID string, campaign model.Campaign) (*model.Campaign, error) {
+	if strings.TrimSpace(campaign.Name) == "" {
+		return nil, errors.New("name is required")
+	}
+	if campaign.ID != "" {
+		if _, err := s.campaignRepo.GetCampaignByID(campaign.ID); err == nil {
+			return nil, errors.New("campaign already exists")
+		}
+	}
+
+	if err := s.inTransaction(func(tx *contentService) error {
+		if err := tx.campaignRepo.CreateCampaign(&campaign); err != nil {
+			return err
+		}
+		return tx.recordVersion(actorID, util.ContentTypeCampaign, campaign.ID, util.ContentActionCreate, campaign)
+	}); err != nil {
+		return nil, err
+	}
+	return &campaign, nil
+}
+
+// UpdateCampaign updates campaign metadata without touching chapters or player progress
+func (s *contentService) UpdateCampaign(actorID, campaignID string, request model.CampaignContentRequest) (*model.Campaign, error) {
+	campaign, err := s.campaignRepo.GetCampaignByID(campaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Name != "" {
+		campaign.Name = request.Name
+	}
+	if request.Description != "" {
+		campaign.Description = request.Description
+	}
+	if request.ImageURL != "" {
+		campaign.ImageURL = request.ImageURL
+	}
+	if request.IsActive != nil {
+		campaign.IsActive = *request.IsActive
+	}
+	campaign.UpdatedAt = time.Now()
+
+	// Snapshot the campaign metadata only
+	snapshot := *campaign
+	snapshot.Chapters = nil
+
+	if err := s.inTransaction(func(tx *contentService) error {
+		if err := tx.campaignRepo.UpdateCampaign(campaign); err != nil {
+			return err
+		}
+		return tx.recordVersion(actorID, util.ContentTypeCampaign, campaign.ID, util.ContentActionUpdate, snapshot)
+	}); err != nil {
+		return nil, err
+	}
+	return campaign, nil
+}
+
+// PublishChapter appends a new chapter to an existing campaign
+func (s *contentService) PublishChapter(actorID, campaignID string, chapter model.Chapter) (*model.Chapter, error) {
+	if strings.TrimSpace(chapter.Name) == "" {
+		return nil, errors.New("name is required")
+	}
+
+	if _, err := s.campaignRepo.GetCampaignByID(campaignID); err != nil {
+		return nil, err
+	}
+	chapters, err := s.campaignRepo.GetChaptersByCampaignID(campaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Default to the next chapter in order
+	chapter.CampaignID = campaignID
+	if chapter.Order <= 0 {
+		chapter.Order = len(chapters) + 1
+	}
+	for _, existing := range chapters {
+		if existing.Order == chapter.Order {
+			return nil, errors.New("a chapter with this order already exists")
+		}
+	}
+
+	if err := s.inTransaction(func(tx *contentService) error {
+		if err := tx.campaignRepo.CreateChapter(&chapter); err != nil {
+			return err
+		}
+		return tx.recordVersion(actorID, util.ContentTypeChapter, chapter.ID, util.ContentActionCreate, chapter)
+	}); err != nil {
+		return nil, err
+	}
+	return &chapter, nil
+}
+
+// GetContentVersions retrieves the change history of content
+func (s *contentService) GetContentVersions(entityType, entityID string, limit int) ([]model.ContentVersion, error) {
+	if limit <= 0 || limit > 500 {
+		limit = 100
+	}
+	return s.contentRepo.GetContentVersions(entityType, entityID, limit)
+}
+
+// inTransaction runs a content change on repositories bound to one transaction,
+// so the change and the version recording it are committed together
+func (s *contentService) inTransaction(fn func(tx *contentService) error) error {
+	return s.db.Transaction(func(db database.Database) error {
+		return fn(&contentService{
+			db:             db,
+			contentRepo:    repository.NewContentRepository(db),
+			territoryRepo:  repository.NewTerritoryRepository(db),
+			operationsRepo: repository.NewOperationsRepository(db),
+			campaignRepo:   repository.NewCampaignRepository(db),
+			logger:         s.logger,
+		})
+	})
+}
+
+// recordVersion stores a snapshot of a content change
+func (s *contentService) recordVersion(actorID, entityType, entityID, action string, entity interface{}) error {
+	snapshot, err := json.Marshal(entity)
+	if err != nil {
+		return err
+	}
+
+	version := &model.ContentVersion{
+		EntityType: entityType,
+		EntityID:   entityID,
+		Action:     action,
+		Snapshot:   string(snapshot),
+		ActorID:    actorID,
+		CreatedAt:  time.Now(),
+	}
+	if err := s.contentRepo.CreateContentVersion(version); err != nil {
+		s.logger.Error().Err(err).Str("entityType", entityType).Str("entityID", entityID).Msg("Failed to record content version")
+		return err
+	}
+
+	s.logger.Info().
+		Str("actorID", actorID).
+		Str("entityType", entityType).
+		Str("entityID", entityID).
+		Str("action", action).
+		Int("version", version.Version).
+		Msg("Content changed")
+	return nil
+}
+
+// findYAMLTemplate looks up a template in operations.yaml
+func (s *contentService) findYAMLTemplate(templateID string) (*OperationTemplate, bool) {
+	operationsData, err := loadOperationsFromYAML()
+	if err != nil {
+		s.logger.Error().Err(err).Msg("Failed to load operations data")
+		return nil, false
+	}
+
+	for _, template := range append(operationsData.BasicOperations, operationsData.SpecialOperations...) {
+		if template.ID == templateID {
+			return &template, true
+		}
+	}
+	return nil, false
+}
+
+// isYAMLTemplate checks whether a template ID comes from operations.yaml
+func (s *contentService) isYAMLTemplate(templateID string) bool {
+	_, found := s.findYAMLTemplate(templateID)
+	return found
+}
@@ -0,0 +1,338 @@
+// internal/service/fortification.go
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"mwce-be/internal/config"
+	"mwce-be/internal/model"
+	"mwce-be/internal/util"
+)
+
+// GetHotspotFortifications retrieves every fortification track for a hotspot the player controls
+func (s *territoryService) GetHotspotFortifications(playerID, hotspotID string) (*model.HotspotFortificationsResponse, error) {
+	hotspot, err := s.territoryRepo.GetHotspotByID(hotspotID)
+	if err != nil {
+		return nil, errors.New("hotspot not found")
+	}
+
+	if hotspot.ControllerID == nil || *hotspot.ControllerID != playerID {
+		return nil, errors.New("you do not control this business")
+	}
+
+	upgrades, err := s.hotspotUpgradesByTrack(hotspot.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &model.HotspotFortificationsResponse{
+		HotspotID: hotspot.ID,
+		Effects:   s.fortificationEffects(upgrades),
+		Tracks:    make([]model.FortificationStatus, 0),
+	}
+
+	for _, trackID := range s.fortificationTrackIDs() {
+		response.Tracks = append(response.Tracks, s.fortificationStatus(trackID, upgrades[trackID]))
+	}
+
+	return response, nil
+}
+
+// PurchaseUpgrade starts building the next level of a fortification track on a controlled hotspot
+func (s *territoryService) PurchaseUpgrade(playerID, hotspotID, trackID string) (*model.PurchaseUpgradeResponse, error) {
+	track, exists := s.gameConfig.Mechanics.Fortifications.Tracks[trackID]
+	if !exists {
+		return nil, errors.New("unknown fortification track")
+	}
+
+	player, err := s.playerRepo.GetPlayerByID(playerID)
+	if err != nil {
+		return nil, errors.New("failed to get player")
+	}
+
+	// Check the player is in a state to act
+	if err := checkPlayerCanAct(player); err != nil {
+		return nil, err
+	}
+
+	hotspot, err := s.territoryRepo.GetHotspotByID(hotspotID)
+	if err != nil {
+		return nil, errors.New("hotspot not found")
+	}
+
+	// Validate the purchase
+	if !hotspot.IsLegal {
+		return nil, errors.New("cannot fortify illegal businesses")
+	}
+
+	if hotspot.ControllerID == nil || *hotspot.ControllerID != playerID {
+		return nil, errors.New("you do not control this business")
+	}
+
+	upgrades, err := s.hotspotUpgradesByTrack(hotspot.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	upgrade := upgrades[trackID]
+	if upgrade == nil {
+		upgrade = &model.HotspotUpgrade{HotspotID: hotspot.ID, Track: trackID}
+	}
+
+	if upgrade.BuildingTo > 0 {
+		return nil, errors.New("an upgrade is already being built on this track")
+	}
+
+	if upgrade.Level >= len(track.Levels) {
+		return nil, errors.New("this track is already at its highest level")
+	}
+
+	nextLevel := track.Levels[upgrade.Level]
+	if player.Money < nextLevel.Cost {
+		return nil, errors.New("not enough money")
+	}
+
+	// Pay for the upgrade and start construction together
+	completesAt := time.Now().Add(time.Duration(nextLevel.BuildTime) * time.Second)
+	upgrade.BuildingTo = upgrade.Level + 1
+	upgrade.CompletesAt = &completesAt
+
+	if err := s.territoryRepo.StartHotspotUpgrade(upgrade, playerID, nextLevel.Cost); err != nil {
+		s.logger.Error().Err(err).Str("hotspotID", hotspot.ID).Str("track", trackID).Msg("Failed to start hotspot upgrade")
+		return nil, err
+	}
+
+	message := fmt.Sprintf("Construction of %s level %d has started at %s.", track.Name, upgrade.BuildingTo, hotspot.Name)
+	if err := s.addNotification(playerID, message, util.NotificationTypeTerritory); err != nil {
+		s.logger.Error().Err(err).Msg("Failed to add fortification upgrade notification")
+	}
+
+	return &model.PurchaseUpgradeResponse{
+		Track:   s.fortificationStatus(trackID, upgrade),
+		Cost:    nextLevel.Cost,
+		Message: message,
+	}, nil
+}
+
+// ProcessFortificationUpgrades completes fortification builds that have finished
+func (s *territoryService) ProcessFortificationUpgrades() error {
+	now := time.Now()
+
+	upgrades, err := s.territoryRepo.GetDueHotspotUpgrades(now)
+	if err != nil {
+		return err
+	}
+
+	for i := range upgrades {
+		upgrade := &upgrades[i]
+		upgrade.Level = upgrade.BuildingTo
+		upgrade.BuildingTo = 0
+		upgrade.CompletesAt = nil
+
+		if err := s.territoryRepo.SaveHotspotUpgrade(upgrade); err != nil {
+			s.logger.Error().Err(err).Str("upgradeID", upgrade.ID).Msg("Failed to complete hotspot upgrade")
+			continue
+		}
+
+		hotspot, err := s.territoryRepo.GetHotspotByID(upgrade.HotspotID)
+		if err != nil {
+			s.logger.Error().Err(err).Str("hotspotID", upgrade.HotspotID).Msg("Failed to get hotspot for completed upgrade")
+			continue
+		}
+
+		// Refresh the defense strength with the new level
+		if err := s.SaveHotspot(hotspot); err != nil {
+			s.logger.Error().Err(err).Str("hotspotID", hotspot.ID).Msg("Failed to update hotspot after upgrade")
+			continue
+		}
+
+		if hotspot.ControllerID == nil {
+			continue
+		}
+
+		trackName := upgrade.Track
+		if track, exists := s.gameConfig.Mechanics.Fortifications.Tracks[upgrade.Track]; exists {
+			trackName = track.Name
+		}
+
+		message := fmt.Sprintf("%s level %d is complete at %s. Current defense strength: %d",
+			trackName, upgrade.Level, hotspot.Name, hotspot.DefenseStrength)
+		if err := s.addNotification(*hotspot.ControllerID, message, util.NotificationTypeTerritory); err != nil {
+			s.logger.Error().Err(err).Msg("Failed to add upgrade completion notification")
+		}
+
+		s.sseService.SendEventToPlayer(*hotspot.ControllerID, "hotspot_upgrade_completed", map[string]interface{}{
+			"upgrade":         upgrade,
+			"hotspotId":       hotspot.ID,
+			"defenseStrength": hotspot.DefenseStrength,
+			"timestamp":       now,
+		})
+	}
+
+	return nil
+}
+
+// loseFortificationsOnTakeover knocks down a share of every track's levels and abandons builds in progress
+func (s *territoryService) loseFortificationsOnTakeover(hotspot *model.Hotspot) {
+	upgrades, err := s.territoryRepo.GetHotspotUpgrades(hotspot.ID)
+	if err != nil {
+		s.logger.Error().Err(err).Str("hotspotID", hotspot.ID).Msg("Failed to get hotspot upgrades after takeover")
+		return
+	}
+
+	lossPercent := s.gameConfig.Mechanics.Fortifications.TakeoverLevelLoss
+	for i := range upgrades {
+		upgrade := &upgrades[i]
+		if upgrade.Level == 0 && upgrade.BuildingTo == 0 {
+			continue
+		}
+
+		// Round the loss up so every fortified track takes some damage
+		lost := (upgrade.Level*lossPercent + 99) / 100
+		upgrade.Level -= lost
+		upgrade.BuildingTo = 0
+		upgrade.CompletesAt = nil
+
+		if err := s.territoryRepo.SaveHotspotUpgrade(upgrade); err != nil {
+			s.logger.Error().Err(err).Str("upgradeID", upgrade.ID).Msg("Failed to reduce hotspot upgrade after takeover")
+		}
+	}
+}
+
+// fortificationChanceModifier applies the collection bonus from a hotspot's fortifications
+func (s *territoryService) fortificationChanceModifier(action *chanceAction) *model.SuccessModifier {
+	if action.ActionType != util.TerritoryActionTypeCollection {
+		return nil
+	}
+
+	upgrades, err := s.hotspotUpgradesByTrack(action.Hotspot.ID)
+	if err != nil {
+		return nil
+	}
+
+	effects := s.fortificationEffects(upgrades)
+	if effects.CollectionBonus == 0 {
+		return nil
+	}
+
+	return &model.SuccessModifier{
+		Source: util.SuccessModifierFortification,
+		Label:  "Fortifications",
+		Value:  effects.CollectionBonus,
+	}
+}
+
+// fortifiedDefense applies a hotspot's fortification effects to its garrison strength
+func (s *territoryService) fortifiedDefense(hotspot *model.Hotspot, garrisonStrength int) int {
+	upgrades, err := s.hotspotUpgradesByTrack(hotspot.ID)
+	if err != nil {
+		s.logger.Error().Err(err).Str("hotspotID", hotspot.ID).Msg("Failed to get hotspot upgrades")
+		return garrisonStrength
+	}
+
+	effects := s.fortificationEffects(upgrades)
+	return (garrisonStrength + effects.DefenseFlat) * (100 + effects.DefensePercent) / 100
+}
+
+// hotspotUpgradesByTrack retrieves a hotspot's upgrades keyed by track
+func (s *territoryService) hotspotUpgradesByTrack(hotspotID string) (map[string]*model.HotspotUpgrade, error) {
+	upgrades, err := s.territoryRepo.GetHotspotUpgrades(hotspotID)
+	if err != nil {
+		s.logger.Error().Err(err).Str("hotspotID", hotspotID).Msg("Failed to get hotspot upgrades")
+		return nil, errors.New("failed to get hotspot upgrades")
+	}
+
+	byTrack := make(map[string]*model.HotspotUpgrade, len(upgrades))
+	for i := range upgrades {
+		byTrack[upgrades[i].Track] = &upgrades[i]
+	}
+
+	return byTrack, nil
+}
+
+// fortificationEffects adds up the effects of every completed level, ignoring tracks no longer configured
+func (s *territoryService) fortificationEffects(upgrades map[string]*model.HotspotUpgrade) model.FortificationEffects {
+	effects := model.FortificationEffects{}
+	for trackID, upgrade := range upgrades {
+		track, exists := s.gameConfig.Mechanics.Fortifications.Tracks[trackID]
+		if !exists {
+			continue
+		}
+
+		levelEffects := trackLevelEffects(track, upgrade.Level)
+		effects.DefenseFlat += levelEffects.DefenseFlat
+		effects.DefensePercent += levelEffects.DefensePercent
+		effects.CollectionBonus += levelEffects.CollectionBonus
+		effects.WarningWindowReduction += levelEffects.WarningWindowReduction
+	}
+
+	// Combined reductions cannot remove the warning window entirely
+	if effects.WarningWindowReduction > 100 {
+		effects.WarningWindowReduction = 100
+	}
+
+	return effects
+}
+
+// fortificationStatus describes a hotspot's progress on one track
+func (s *territoryService) fortificationStatus(trackID string, upgrade *model.HotspotUpgrade) model.FortificationStatus {
+	track := s.gameConfig.Mechanics.Fortifications.Tracks[trackID]
+
+	status := model.FortificationStatus{
+		Track:       trackID,
+		Name:        track.Name,
+		Description: track.Description,
+		MaxLevel:    len(track.Levels),
+	}
+
+	if upgrade != nil {
+		status.Level = upgrade.Level
+		status.BuildingTo = upgrade.BuildingTo
+		status.CompletesAt = upgrade.CompletesAt
+	}
+	status.Effects = trackLevelEffects(track, status.Level)
+
+	if status.Level < len(track.Levels) {
+		level := track.Levels[status.Level]
+		status.NextLevel = &model.FortificationLevelInfo{
+			Level:     status.Level + 1,
+			Cost:      level.Cost,
+			BuildTime: level.BuildTime,
+			Effects:   trackLevelEffects(track, status.Level+1),
+		}
+	}
+
+	return status
+}
+
+// fortificationTrackIDs lists the configured tracks in a stable order
+func (s *territoryService) fortificationTrackIDs() []string {
+	trackIDs := make([]string, 0, len(s.gameConfig.Mechanics.Fortifications.Tracks))
+	for trackID := range s.gameConfig.Mechanics.Fortifications.Tracks {
+		trackIDs = append(trackIDs, trackID)
+	}
+	sort.Strings(trackIDs)
+	return trackIDs
+}
+
+// trackLevelEffects looks up the effects of a track at a level, where level 0 has none
+func trackLevelEffects(track config.FortificationTrack, level int) model.FortificationEffects {
+	if level <= 0 {
+		return model.FortificationEffects{}
+	}
+	if level > len(track.Levels) {
+		level = len(track.Levels)
+	}
+
+	l := track.Levels[level-1]
+	return model.FortificationEffects{
+		DefenseFlat:            l.DefenseFlat,
+		DefensePercent:         l.DefensePercent,
+		CollectionBonus:        l.CollectionBonus,
+		WarningWindowReduction: l.WarningWindowReduction,
+	}
+}
@@ -0,0 +1,364 @@
+// internal/service/garrison.go
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"mwce-be/internal/model"
+	"mwce-be/internal/util"
+)
+
+// handleWithdraw processes a withdraw action, returning garrison resources to the player
+func (s *territoryService) handleWithdraw(player *model.Player, hotspot *model.Hotspot, resources model.ActionResources) (*model.ActionResult, error) {
+	// Validate the action
+	if hotspot.ControllerID == nil || *hotspot.ControllerID != player.ID {
+		return nil, errors.New("you do not control this business")
+	}
+
+	if err := validateGarrisonMove(hotspot, resources); err != nil {
+		return nil, err
+	}
+
+	// Remove resources from the hotspot defense
+	hotspot.Crew -= resources.Crew
+	hotspot.Weapons -= resources.Weapons
+	hotspot.Vehicles -= resources.Vehicles
+
+	// Update the hotspot
+	if err := s.SaveHotspot(hotspot); err != nil {
+		s.logger.Error().Err(err).Msg("Failed to update hotspot after withdrawal")
+		return nil, errors.New("failed to update hotspot")
+	}
+
+	// Return the resources to the player
+	resourceUpdates := map[string]int{
+		"crew":     resources.Crew,
+		"weapons":  resources.Weapons,
+		"vehicles": resources.Vehicles,
+	}
+	if err := s.updatePlayerResources(player.ID, resourceUpdates); err != nil {
+		s.logger.Error().Err(err).Msg("Failed to update player resources after withdrawal")
+		return nil, errors.New("failed to update player resources")
+	}
+
+	result := &model.ActionResult{
+		Success: true,
+		Message: fmt.Sprintf("Withdrew %d crew, %d weapons and %d vehicles from %s. Current defense strength: %d",
+			resources.Crew, resources.Weapons, resources.Vehicles, hotspot.Name, hotspot.DefenseStrength),
+	}
+
+	// Add notification
+	if err := s.addNotification(player.ID, result.Message, util.NotificationTypeTerritory); err != nil {
+		s.logger.Error().Err(err).Msg("Failed to add notification after withdraw action")
+	}
+
+	return result, nil
+}
+
+// handleTransfer processes a transfer action, moving garrison resources to another controlled hotspot
+func (s *territoryService) handleTransfer(player *model.Player, hotspot *model.Hotspot, targetHotspotID string, resources model.ActionResources) (*model.ActionResult, error) {
+	// Validate the action
+	if targetHotspotID == "" {
+		return nil, errors.New("target hotspot ID is required")
+	}
+
+	if targetHotspotID == hotspot.ID {
+		return nil, errors.New("cannot transfer a garrison to the same business")
+	}
+
+	if hotspot.ControllerID == nil || *hotspot.ControllerID != player.ID {
+		return nil, errors.New("you do not control this business")
+	}
+
+	target, err := s.territoryRepo.GetHotspotByID(targetHotspotID)
+	if err != nil {
+		return nil, errors.New("target hotspot not found")
+	}
+
+	if !target.IsLegal {
+		return nil, errors.New("cannot garrison illegal businesses")
+	}
+
+	if target.ControllerID == nil || *target.ControllerID != player.ID {
+		return nil, errors.New("you do not control the target business")
+	}
+
+	if err := validateGarrisonMove(hotspot, resources); err != nil {
+		return nil, err
+	}
+
+	// Work out whether the garrison has to travel between regions
+	sourceRegionID, err := s.territoryRepo.GetHotspotRegionID(hotspot.ID)
+	if err != nil {
+		return nil, errors.New("failed to locate hotspot")
+	}
+	targetRegionID, err := s.territoryRepo.GetHotspotRegionID(target.ID)
+	if err != nil {
+		return nil, errors.New("failed to locate target hotspot")
+	}
+
+	// Remove resources from the source hotspot
+	hotspot.Crew -= resources.Crew
+	hotspot.Weapons -= resources.Weapons
+	hotspot.Vehicles -= resources.Vehicles
+
+	if err := s.SaveHotspot(hotspot); err != nil {
+		s.logger.Error().Err(err).Msg("Failed to update hotspot after transfer")
+		return nil, errors.New("failed to update hotspot")
+	}
+
+	result := &model.ActionResult{Success: true}
+
+	travelTime := time.Duration(s.gameConfig.Mechanics.TerritoryActions.Garrison.CrossRegionTransferTime) * time.Second
+	if sourceRegionID == targetRegionID || travelTime <= 0 {
+		// Within a region the garrison arrives immediately
+		target.Crew += resources.Crew
+		target.Weapons += resources.Weapons
+		target.Vehicles += resources.Vehicles
+
+		if err := s.SaveHotspot(target); err != nil {
+			s.logger.Error().Err(err).Msg("Failed to update target hotspot after transfer")
+			return nil, errors.New("failed to update target hotspot")
+		}
+
+		result.Message = fmt.Sprintf("Moved %d crew, %d weapons and %d vehicles from %s to %s. Defense strength: %d / %d",
+			resources.Crew, resources.Weapons, resources.Vehicles, hotspot.Name, target.Name,
+			hotspot.DefenseStrength, target.DefenseStrength)
+	} else {
+		// Across regions the garrison has to travel
+		now := time.Now()
+		transfer := &model.GarrisonTransfer{
+			PlayerID:      player.ID,
+			FromHotspotID: hotspot.ID,
+			ToHotspotID:   target.ID,
+			Resources:     resources,
+			Status:        util.GarrisonTransferStatusInTransit,
+			DepartedAt:    now,
+			ArrivesAt:     now.Add(travelTime),
+		}
+
+		if err := s.territoryRepo.CreateGarrisonTransfer(transfer); err != nil {
+			s.logger.Error().Err(err).Msg("Failed to create garrison transfer")
+			return nil, errors.New("failed to start garrison transfer")
+		}
+
+		result.Message = fmt.Sprintf("%d crew, %d weapons and %d vehicles left %s and will reach %s in %s.",
+			resources.Crew, resources.Weapons, resources.Vehicles, hotspot.Name, target.Name, travelTime.Round(time.Second))
+	}
+
+	// Add notification
+	if err := s.addNotification(player.ID, result.Message, util.NotificationTypeTerritory); err != nil {
+		s.logger.Error().Err(err).Msg("Failed to add notification after transfer action")
+	}
+
+	return result, nil
+}
+
+// GetGarrisonTransfers retrieves a player's garrison transfers that are still in transit
+func (s *territoryService) GetGarrisonTransfers(playerID string) ([]model.GarrisonTransfer, error) {
+	return s.territoryRepo.GetGarrisonTransfersInTransit(playerID)
+}
+
+// ProcessGarrisonTransfers completes transfers that have reached their destination
+func (s *territoryService) ProcessGarrisonTransfers() error {
+	now := time.Now()
+
+	transfers, err := s.territoryRepo.GetDueGarrisonTransfers(now)
+	if err != nil {
+		return err
+	}
+
+	for i := range transfers {
+		transfer := &transfers[i]
+		resources := transfer.Resources
+
+		// The garrison only joins the target if the player still controls it
+		target, err := s.territoryRepo.GetHotspotByID(transfer.ToHotspotID)
+		if err == nil && target.ControllerID != nil && *target.ControllerID == transfer.PlayerID {
+			target.Crew += resources.Crew
+			target.Weapons += resources.Weapons
+			target.Vehicles += resources.Vehicles
+
+			if err := s.SaveHotspot(target); err != nil {
+				s.logger.Error().Err(err).Str("transferID", transfer.ID).Msg("Failed to add transferred garrison to hotspot")
+				continue
+			}
+
+			transfer.Status = util.GarrisonTransferStatusArrived
+			message := fmt.Sprintf("Your garrison of %d crew, %d weapons and %d vehicles has arrived at %s.",
+				resources.Crew, resources.Weapons, resources.Vehicles, target.Name)
+			if err := s.addNotification(transfer.PlayerID, message, util.NotificationTypeTerritory); err != nil {
+				s.logger.Error().Err(err).Msg("Failed to add garrison arrival notification")
+			}
+		} else {
+			// Otherwise the resources make their way back to the player
+			resourceUpdates := map[string]int{
+				"crew":     resources.Crew,
+				"weapons":  resources.Weapons,
+				"vehicles": resources.Vehicles,
+			}
+			if err := s.updatePlayerResources(transfer.PlayerID, resourceUpdates); err != nil {
+				s.logger.Error().Err(err).Str("transferID", transfer.ID).Msg("Failed to return transferred garrison to player")
+				continue
+			}
+
+			transfer.Status = util.GarrisonTransferStatusReturned
+			message := "Your garrison arrived to find the business no longer yours and returned to you."
+			if err := s.addNotification(transfer.PlayerID, message, util.NotificationTypeTerritory); err != nil {
+				s.logger.Error().Err(err).Msg("Failed to add garrison return notification")
+			}
+		}
+
+		transfer.CompletedAt = &now
+		if err := s.territoryRepo.UpdateGarrisonTransfer(transfer); err != nil {
+			s.logger.Error().Err(err).Str("transferID", transfer.ID).Msg("Failed to update garrison transfer")
+			continue
+		}
+
+		s.sseService.SendEventToPlayer(transfer.PlayerID, "garrison_transfer_completed", map[string]interface{}{
+			"transfer":  transfer,
+			"timestamp": now,
+		})
+	}
+
+	return nil
+}
+
+// RebalanceGarrisons spreads the garrisons of every controlled hotspot in the player's current region
+func (s *territoryService) RebalanceGarrisons(playerID string, request model.RebalanceGarrisonsRequest) (*model.RebalanceGarrisonsResponse, error) {
+	strategy := request.Strategy
+	if strategy == "" {
+		strategy = util.RebalanceStrategyEven
+	}
+	if strategy != util.RebalanceStrategyEven && strategy != util.RebalanceStrategyIncome {
+		return nil, errors.New("invalid rebalance strategy")
+	}
+
+	player, err := s.playerRepo.GetPlayerByID(playerID)
+	if err != nil {
+		return nil, errors.New("failed to get player")
+	}
+	if err := checkPlayerCanAct(player); err != nil {
+		return nil, err
+	}
+
+	hotspots, err := s.GetControlledHotspotsInCurrentRegion(playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(hotspots) < 2 {
+		return nil, errors.New("you need at least two businesses in this region to rebalance")
+	}
+
+	// Weigh each hotspot by the chosen strategy
+	weights := make([]int, len(hotspots))
+	totalWeight := 0
+	for i, hotspot := range hotspots {
+		weights[i] = 1
+		if strategy == util.RebalanceStrategyIncome {
+			weights[i] = hotspot.Income
+		}
+		totalWeight += weights[i]
+	}
+	if totalWeight <= 0 {
+		for i := range weights {
+			weights[i] = 1
+		}
+	}
+
+	// Pool the garrisons and share them out again
+	var crew, weapons, vehicles int
+	for _, hotspot := range hotspots {
+		crew += hotspot.Crew
+		weapons += hotspot.Weapons
+		vehicles += hotspot.Vehicles
+	}
+
+	crewShares := distributeByWeight(crew, weights)
+	weaponShares := distributeByWeight(weapons, weights)
+	vehicleShares := distributeByWeight(vehicles, weights)
+
+	for i := range hotspots {
+		hotspots[i].Crew = crewShares[i]
+		hotspots[i].Weapons = weaponShares[i]
+		hotspots[i].Vehicles = vehicleShares[i]
+		hotspots[i].DefenseStrength = s.calculateDefenseStrength(&hotspots[i])
+	}
+
+	if err := s.territoryRepo.UpdateHotspotGarrisons(hotspots); err != nil {
+		s.logger.Error().Err(err).Msg("Failed to save rebalanced garrisons")
+		return nil, errors.New("failed to rebalance garrisons")
+	}
+
+	message := fmt.Sprintf("Rebalanced %d crew, %d weapons and %d vehicles across %d businesses.",
+		crew, weapons, vehicles, len(hotspots))
+
+	// Add notification
+	if err := s.addNotification(playerID, message, util.NotificationTypeTerritory); err != nil {
+		s.logger.Error().Err(err).Msg("Failed to add notification after rebalance")
+	}
+
+	return &model.RebalanceGarrisonsResponse{
+		Hotspots: hotspots,
+		Message:  message,
+	}, nil
+}
+
+// validateGarrisonMove checks that resources can be taken out of a hotspot's garrison
+func validateGarrisonMove(hotspot *model.Hotspot, resources model.ActionResources) error {
+	if resources.Crew < 0 || resources.Weapons < 0 || resources.Vehicles < 0 {
+		return errors.New("resource amounts cannot be negative")
+	}
+	if resources.Crew+resources.Weapons+resources.Vehicles == 0 {
+		return errors.New("no resources selected")
+	}
+	if resources.Crew > hotspot.Crew {
+		return errors.New("not enough crew in the garrison")
+	}
+	if resources.Weapons > hotspot.Weapons {
+		return errors.New("not enough weapons in the garrison")
+	}
+	if resources.Vehicles > hotspot.Vehicles {
+		return errors.New("not enough vehicles in the garrison")
+	}
+	return nil
+}
+
+// distributeByWeight shares an amount out by weight, handing any remainder to the heaviest first
+func distributeByWeight(amount int, weights []int) []int {
+	shares := make([]int, len(weights))
+
+	totalWeight := 0
+	for _, weight := range weights {
+		totalWeight += weight
+	}
+	if totalWeight <= 0 {
+		return shares
+	}
+
+	remaining := amount
+	for i, weight := range weights {
+		shares[i] = amount * weight / totalWeight
+		remaining -= shares[i]
+	}
+
+	order := make([]int, len(weights))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return weights[order[a]] > weights[order[b]]
+	})
+
+	for i := 0; remaining > 0; i = (i + 1) % len(order) {
+		shares[order[i]]++
+		remaining--
+	}
+
+	return shares
+}
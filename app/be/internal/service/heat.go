@@ -0,0 +1,208 @@
+// internal/service/heat.go
+
+package service
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"mwce-be/internal/config"
+	"mwce-be/internal/model"
+	"mwce-be/internal/repository"
+	"mwce-be/internal/util"
+
+	"github.com/rs/zerolog"
+)
+
+// HeatService handles heat decay, capping and warnings
+type HeatService interface {
+	GetHeatHistory(playerID string, hours int) (*model.HeatHistoryResponse, error)
+	ProcessHeat() error
+
+	// Scheduled jobs
+	StartPeriodicHeatDecay()
+}
+
+type heatService struct {
+	playerRepo       repository.PlayerRepository
+	territoryService TerritoryService
+	sseService       SSEService
+	gameConfig       config.GameConfig
+	logger           zerolog.Logger
+}
+
+// NewHeatService creates a new heat service
+func NewHeatService(
+	playerRepo repository.PlayerRepository,
+	territoryService TerritoryService,
+	sseService SSEService,
+	gameConfig config.GameConfig,
+	logger zerolog.Logger,
+) HeatService {
+	return &heatService{
+		playerRepo:       playerRepo,
+		territoryService: territoryService,
+		sseService:       sseService,
+		gameConfig:       gameConfig,
+		logger:           logger,
+	}
+}
+
+// GetHeatHistory brings a player's heat up to date and returns its recent history
+func (s *heatService) GetHeatHistory(playerID string, hours int) (*model.HeatHistoryResponse, error) {
+	if hours <= 0 || hours > 24*7 {
+		hours = 24
+	}
+
+	player, err := s.playerRepo.GetPlayerByID(playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply any decay that has built up since the last pass
+	if err := s.processPlayerHeat(player); err != nil {
+		return nil, err
+	}
+
+	history, err := s.playerRepo.GetHeatHistory(playerID, time.Now().Add(-time.Duration(hours)*time.Hour))
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.HeatHistoryResponse{
+		Heat:              player.Heat,
+		MaxHeat:           s.maxHeat(),
+		DecayRate:         s.gameConfig.Mechanics.Heat.DecayRate,
+		WarningLevel:      player.HeatWarningLevel,
+		WarningThresholds: s.warningThresholds(),
+		History:           history,
+	}, nil
+}
+
+// ProcessHeat decays, caps and samples the heat of every player with heat activity
+func (s *heatService) ProcessHeat() error {
+	players, err := s.playerRepo.GetPlayersWithHeatActivity()
+	if err != nil {
+		return err
+	}
+
+	for i := range players {
+		if err := s.processPlayerHeat(&players[i]); err != nil {
+			s.logger.Error().Err(err).Str("playerID", players[i].ID).Msg("Failed to process player heat")
+		}
+	}
+
+	return nil
+}
+
+// processPlayerHeat applies decay and the cap to a player, then records history and warnings.
+// The player is updated in place with the new heat and warning level.
+func (s *heatService) processPlayerHeat(player *model.Player) error {
+	now := time.Now()
+	decayRate := s.gameConfig.Mechanics.Heat.DecayRate
+
+	// Work out how many whole points have decayed, keeping the remainder for the next pass.
+	// If heat went up since the last sample the clock restarts, so fresh heat isn't wiped by idle time.
+	decay := 0
+	updatedAt := now
+	if player.HeatUpdatedAt != nil && player.Heat > 0 && player.Heat <= player.LastHeatSample && decayRate > 0 {
+		elapsed := now.Sub(*player.HeatUpdatedAt)
+		decay = int(elapsed.Hours() * float64(decayRate))
+		if decay < player.Heat {
+			updatedAt = player.HeatUpdatedAt.Add(time.Duration(decay) * time.Hour / time.Duration(decayRate))
+		}
+	}
+
+	heat, err := s.playerRepo.ApplyHeatDecay(player.ID, decay, s.maxHeat(), updatedAt)
+	if err != nil {
+		return err
+	}
+	player.Heat = heat
+	player.HeatUpdatedAt = &updatedAt
+
+	// Sample the heat for charts whenever it has moved
+	if heat != player.LastHeatSample {
+		// Heat from any source lands here, so this is where crossing an income penalty threshold reprices the player's businesses
+		_, previousThreshold := heatPenalty(s.gameConfig.Mechanics, util.HeatEffectIncomePenalty, player.LastHeatSample)
+		if _, threshold := heatPenalty(s.gameConfig.Mechanics, util.HeatEffectIncomePenalty, heat); threshold != previousThreshold {
+			if err := s.territoryService.RefreshPlayerIncome(player.ID); err != nil {
+				s.logger.Error().Err(err).Str("playerID", player.ID).Msg("Failed to refresh income after heat change")
+			}
+		}
+
+		if err := s.playerRepo.RecordHeatSample(player.ID, heat, now); err != nil {
+			return err
+		}
+		player.LastHeatSample = heat
+	}
+
+	return s.updateWarningLevel(player)
+}
+
+// updateWarningLevel warns a player who has crossed a new heat threshold
+func (s *heatService) updateWarningLevel(player *model.Player) error {
+	thresholds := s.warningThresholds()
+
+	level := 0
+	for _, threshold := range thresholds {
+		if player.Heat >= threshold {
+			level++
+		}
+	}
+
+	if level == player.HeatWarningLevel {
+		return nil
+	}
+
+	previousLevel := player.HeatWarningLevel
+	if err := s.playerRepo.UpdateHeatWarningLevel(player.ID, level); err != nil {
+		return err
+	}
+	player.HeatWarningLevel = level
+
+	// Only warn on the way up; cooling down is silent
+	if level < previousLevel {
+		return nil
+	}
+
+	threshold := thresholds[level-1]
+	message := fmt.Sprintf("Your heat has reached %d. The police are paying closer attention to your activities.", threshold)
+	if level == len(thresholds) {
+		message = fmt.Sprintf("Your heat has reached %d. The police are closing in, lie low before it's too late.", threshold)
+	}
+
+	if err := s.playerRepo.AddNotification(&model.Notification{
+		PlayerID:  player.ID,
+		Message:   message,
+		Type:      util.NotificationTypeHeat,
+		Timestamp: time.Now(),
+		Read:      false,
+	}); err != nil {
+		s.logger.Error().Err(err).Str("playerID", player.ID).Msg("Failed to add heat warning notification")
+	}
+
+	s.sseService.SendEventToPlayer(player.ID, "heat_warning", map[string]interface{}{
+		"heat":         player.Heat,
+		"threshold":    threshold,
+		"warningLevel": level,
+		"message":      message,
+	})
+
+	return nil
+}
+
+// warningThresholds returns the configured warning thresholds in ascending order
+func (s *heatService) warningThresholds() []int {
+	thresholds := append([]int(nil), s.gameConfig.Mechanics.Heat.WarningThresholds...)
+	sort.Ints(thresholds)
+	return thresholds
+}
+
+// maxHeat returns the configured heat cap, falling back to 100
+func (s *heatService) maxHeat() int {
+	if s.gameConfig.Mechanics.Heat.MaxHeat > 0 {
+		return s.gameConfig.Mechanics.Heat.MaxHeat
+	}
+	return 100
+}
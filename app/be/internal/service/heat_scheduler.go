@@ -0,0 +1,30 @@
+// internal/service/heat_scheduler.go
+
+package service
+
+import (
+	"time"
+)
+
+// StartPeriodicHeatDecay starts a goroutine that decays and caps player heat
+func (s *heatService) StartPeriodicHeatDecay() {
+	// Get interval from config, or use default of 60 seconds
+	updateInterval := 60 * time.Second
+	if s.gameConfig.Mechanics.Heat.UpdateInterval > 0 {
+		updateInterval = time.Duration(s.gameConfig.Mechanics.Heat.UpdateInterval) * time.Second
+	}
+
+	s.logger.Info().
+		Dur("interval", updateInterval).
+		Msg("Starting periodic heat decay")
+
+	// Start ticker for periodic decay
+	ticker := time.NewTicker(updateInterval)
+	go func() {
+		for range ticker.C {
+			if err := s.ProcessHeat(); err != nil {
+				s.logger.Error().Err(err).Msg("Failed to process heat")
+			}
+		}
+	}()
+}
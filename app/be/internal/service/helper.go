@@ -3,8 +3,13 @@
 package service
 
 import (
+	"errors"
 	"fmt"
+	"math/rand"
 	"time"
+
+	"mwce-be/internal/config"
+	"mwce-be/internal/model"
 )
 
 // Helper function to format money
@@ -23,7 +28,44 @@ func formatMessage(title string, format string, a ...interface{}) string {
 	return fmt.Sprintf("%s: %s", title, content)
 }
 
+// checkPlayerCanAct returns an error when the player's current state keeps them from acting in a region
+func checkPlayerCanAct(player *model.Player) error {
+	if isJailed(player, time.Now()) {
+		return fmt.Errorf("you are in jail until %s", player.JailedUntil.Format(time.Kitchen))
+	}
+	if player.InTransitTo != nil {
+		return errors.New("you cannot do that while travelling")
+	}
+	return nil
+}
+
+// isJailed reports whether the player is still serving a sentence
+func isJailed(player *model.Player, now time.Time) bool {
+	return player.JailedUntil != nil && player.JailedUntil.After(now)
+}
+
 // ptrTime creates a pointer to a time.Time
 func ptrTime(t time.Time) *time.Time {
 	return &t
 }
+
+// rollChance rolls a percentage chance
+func rollChance(chance int) bool {
+	return rand.Intn(100) < chance
+}
+
+// rollRange picks a whole number in an inclusive range
+func rollRange(r config.IntRange) int {
+	if r.Max <= r.Min {
+		return r.Min
+	}
+	return r.Min + rand.Intn(r.Max-r.Min+1)
+}
+
+// rollSteppedRange picks a number in an inclusive range in increments of step
+func rollSteppedRange(r config.IntRange, step int) int {
+	if step <= 0 || r.Max <= r.Min {
+		return rollRange(r)
+	}
+	return r.Min + rand.Intn((r.Max-r.Min)/step+1)*step
+}
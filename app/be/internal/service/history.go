@@ -0,0 +1,100 @@
+// internal/service/history.go
+
+package service
+
+import (
+	"errors"
+	"time"
+
+	"mwce-be/internal/model"
+)
+
+// hotspotHistoryActionLimit caps how many recent actions a hotspot history includes
+const hotspotHistoryActionLimit = 20
+
+// GetHotspotHistory retrieves a hotspot's ownership timeline and recent actions against it.
+// Players who don't control the hotspot only see who acted on it when it was themselves.
+func (s *territoryService) GetHotspotHistory(playerID, hotspotID string) (*model.HotspotHistoryResponse, error) {
+	hotspot, err := s.territoryRepo.GetHotspotByID(hotspotID)
+	if err != nil {
+		return nil, errors.New("hotspot not found")
+	}
+
+	ownership, err := s.territoryRepo.GetHotspotOwnership(hotspotID)
+	if err != nil {
+		s.logger.Error().Err(err).Str("hotspotID", hotspotID).Msg("Failed to get hotspot ownership")
+		return nil, errors.New("failed to get hotspot history")
+	}
+
+	actions, err := s.territoryRepo.GetRecentActionsByHotspot(hotspotID, hotspotHistoryActionLimit)
+	if err != nil {
+		s.logger.Error().Err(err).Str("hotspotID", hotspotID).Msg("Failed to get hotspot actions")
+		return nil, errors.New("failed to get hotspot history")
+	}
+
+	redacted := hotspot.ControllerID == nil || *hotspot.ControllerID != playerID
+	names := make(map[string]string)
+
+	// Ownership is public knowledge on the street
+	for i := range ownership {
+		period := &ownership[i]
+		period.ControllerName = s.playerName(names, period.ControllerID)
+		if period.LostToID != nil {
+			period.LostToName = s.playerName(names, *period.LostToID)
+		}
+	}
+
+	entries := make([]model.HotspotHistoryEntry, 0, len(actions))
+	for _, action := range actions {
+		entry := model.HotspotHistoryEntry{
+			ID:        action.ID,
+			Type:      action.Type,
+			Timestamp: action.Timestamp,
+		}
+		if action.Result != nil {
+			entry.Success = action.Result.Success
+		}
+
+		// Outsiders only learn the details of their own actions
+		if !redacted || action.PlayerID == playerID {
+			resources := action.Resources
+			entry.PlayerID = action.PlayerID
+			entry.PlayerName = s.playerName(names, action.PlayerID)
+			entry.Resources = &resources
+			if action.Result != nil {
+				entry.Message = action.Result.Message
+			}
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return &model.HotspotHistoryResponse{
+		HotspotID:   hotspot.ID,
+		HotspotName: hotspot.Name,
+		Redacted:    redacted,
+		Ownership:   ownership,
+		Actions:     entries,
+	}, nil
+}
+
+// recordOwnershipChange closes the hotspot's current ownership period and opens one for the new controller
+func (s *territoryService) recordOwnershipChange(hotspotID string, newControllerID *string, how string) {
+	if err := s.territoryRepo.RecordOwnershipChange(hotspotID, newControllerID, how, time.Now()); err != nil {
+		s.logger.Error().Err(err).Str("hotspotID", hotspotID).Msg("Failed to record hotspot ownership change")
+	}
+}
+
+// playerName looks up a player's name, remembering it for the rest of the request
+func (s *territoryService) playerName(names map[string]string, playerID string) string {
+	if name, cached := names[playerID]; cached {
+		return name
+	}
+
+	name := ""
+	if player, err := s.playerRepo.GetPlayerByID(playerID); err == nil {
+		name = player.Name
+	}
+	names[playerID] = name
+	return name
+}
@@ -0,0 +1,254 @@
+// internal/service/income.go
+
+package service
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"mwce-be/internal/model"
+	"mwce-be/internal/util"
+)
+
+// calculateIncome works out a hotspot's hourly income from its type, business and controller.
+// The controller may be nil, in which case only the hotspot's own factors apply.
+// The bonus map may be nil, in which case the neighborhood bonuses are looked up for this hotspot alone.
+func (s *territoryService) calculateIncome(hotspot *model.Hotspot, controller *model.Player, bonusMap *NeighborhoodBonusMap) *model.IncomeBreakdown {
+	tuning := s.gameConfig.Mechanics.Income
+
+	breakdown := &model.IncomeBreakdown{
+		HotspotType: hotspot.Type,
+		BaseRate:    tuning.BaseRates[strings.ToLower(hotspot.Type)],
+		Factors:     []model.IncomeFactor{},
+	}
+
+	// Business type
+	if multiplier, exists := tuning.Multipliers[strings.ToLower(hotspot.BusinessType)]; exists {
+		breakdown.Factors = append(breakdown.Factors, model.IncomeFactor{
+			Source:     util.IncomeFactorBusiness,
+			Label:      fmt.Sprintf("%s business", hotspot.BusinessType),
+			Multiplier: multiplier,
+		})
+	}
+
+	if controller != nil {
+		// Controller title
+		if multiplier, exists := tuning.TitleMultipliers[strings.ToLower(controller.Title)]; exists {
+			breakdown.Factors = append(breakdown.Factors, model.IncomeFactor{
+				Source:     util.IncomeFactorTitle,
+				Label:      fmt.Sprintf("%s title", controller.Title),
+				Multiplier: multiplier,
+			})
+		}
+
+		// Police attention on the controller scares off business
+		if penalty, threshold := heatPenalty(s.gameConfig.Mechanics, util.HeatEffectIncomePenalty, controller.Heat); penalty > 0 {
+			breakdown.Factors = append(breakdown.Factors, model.IncomeFactor{
+				Source:     util.IncomeFactorHeat,
+				Label:      fmt.Sprintf("Police attention (heat %d+)", threshold),
+				Multiplier: float64(100-penalty) / 100,
+			})
+		}
+
+		// Neighborhood control
+		var bonuses []model.NeighborhoodBonus
+		if bonusMap != nil {
+			bonuses = bonusMap.HotspotBonuses(hotspot)
+		} else {
+			var err error
+			if bonuses, err = s.neighborhoodService.GetHotspotBonuses(hotspot); err != nil {
+				s.logger.Error().Err(err).Str("hotspotID", hotspot.ID).Msg("Failed to get neighborhood bonuses for hotspot")
+			}
+		}
+		for _, bonus := range bonuses {
+			if bonus.IncomeMultiplier == 1 {
+				continue
+			}
+			breakdown.Factors = append(breakdown.Factors, model.IncomeFactor{
+				Source:     util.IncomeFactorNeighborhood,
+				Label:      fmt.Sprintf("%s (%s)", bonus.Name, bonus.AreaName),
+				Multiplier: bonus.IncomeMultiplier,
+			})
+		}
+	}
+
+	income := float64(breakdown.BaseRate)
+	for _, factor := range breakdown.Factors {
+		income *= factor.Multiplier
+	}
+	breakdown.HourlyIncome = int(math.Round(income))
+
+	return breakdown
+}
+
+// refreshHotspotIncome recalculates a hotspot's hourly income, looking up its controller when needed
+func (s *territoryService) refreshHotspotIncome(hotspot *model.Hotspot, controller *model.Player, bonusMap *NeighborhoodBonusMap) *model.IncomeBreakdown {
+	if controller == nil && hotspot.ControllerID != nil {
+		player, err := s.playerRepo.GetPlayerByID(*hotspot.ControllerID)
+		if err != nil {
+			s.logger.Error().Err(err).Str("hotspotID", hotspot.ID).Msg("Failed to get hotspot controller for income")
+		} else {
+			controller = player
+		}
+	}
+
+	breakdown := s.calculateIncome(hotspot, controller, bonusMap)
+	hotspot.Income = breakdown.HourlyIncome
+	hotspot.IncomeBreakdown = breakdown
+
+	return breakdown
+}
+
+// RefreshPlayerIncome recalculates the income of every legal hotspot a player controls
+func (s *territoryService) RefreshPlayerIncome(playerID string) error {
+	player, err := s.playerRepo.GetPlayerByID(playerID)
+	if err != nil {
+		return err
+	}
+
+	hotspots, err := s.territoryRepo.GetControlledHotspots(playerID)
+	if err != nil {
+		return err
+	}
+
+	// Neighborhood control is counted once for the whole pass rather than per hotspot
+	bonusMap, err := s.neighborhoodService.GetBonusMap()
+	if err != nil {
+		return err
+	}
+
+	for i := range hotspots {
+		hotspot := &hotspots[i]
+		if !hotspot.IsLegal {
+			continue
+		}
+
+		// Income earned so far is paid at the old rate before it changes
+		previousIncome := hotspot.Income
+		accrued := accrueIncome(hotspot, time.Now())
+		s.refreshHotspotIncome(hotspot, player, bonusMap)
+		if hotspot.Income == previousIncome && accrued == 0 {
+			continue
+		}
+
+		if err := s.SaveHotspot(hotspot); err != nil {
+			s.logger.Error().Err(err).Str("hotspotID", hotspot.ID).Msg("Failed to update hotspot income")
+		}
+	}
+
+	return nil
+}
+
+// refreshIncome recalculates a player's stored income rates, logging rather than failing the caller
+func (s *territoryService) refreshIncome(playerID string) {
+	if err := s.RefreshPlayerIncome(playerID); err != nil {
+		s.logger.Error().Err(err).Str("playerID", playerID).Msg("Failed to refresh player income")
+	}
+}
+
+// accrueIncome adds the income earned in every full hour since the hotspot last paid out to its pending collection.
+// A controlled hotspot that never had its income clock started starts earning from now.
+// It only changes the hotspot in memory, callers save it when the income should be materialized.
+func accrueIncome(hotspot *model.Hotspot, now time.Time) int {
+	if !hotspot.IsLegal || hotspot.ControllerID == nil {
+		return 0
+	}
+	if hotspot.LastIncomeTime == nil {
+		start := now
+		hotspot.LastIncomeTime = &start
+		return 0
+	}
+
+	hours := int(now.Sub(*hotspot.LastIncomeTime) / time.Hour)
+	if hours <= 0 {
+		return 0
+	}
+
+	accrued := hotspot.Income * hours
+	hotspot.PendingCollection += accrued
+
+	lastIncomeTime := hotspot.LastIncomeTime.Add(time.Duration(hours) * time.Hour)
+	hotspot.LastIncomeTime = &lastIncomeTime
+
+	return accrued
+}
+
+// accrueHotspotsIncome brings the pending collection of every hotspot in a list up to date
+func accrueHotspotsIncome(hotspots []model.Hotspot) []model.Hotspot {
+	now := time.Now()
+	for i := range hotspots {
+		accrueIncome(&hotspots[i], now)
+	}
+	return hotspots
+}
+
+// NotifyAccruedIncome tells connected players about income their hotspots earned between two points in time
+func (s *territoryService) NotifyAccruedIncome(since, now time.Time) error {
+	regions := make(map[string]*model.Region)
+
+	for _, playerID := range s.sseService.GetConnectedPlayerIDs() {
+		hotspots, err := s.territoryRepo.GetControlledHotspots(playerID)
+		if err != nil {
+			s.logger.Error().Err(err).Str("playerID", playerID).Msg("Failed to get controlled hotspots for income events")
+			continue
+		}
+
+		for i := range hotspots {
+			hotspot := &hotspots[i]
+			if !hotspot.IsLegal || hotspot.LastIncomeTime == nil {
+				continue
+			}
+
+			// Only hotspots that passed an hour mark since the last check have news
+			hoursBefore := int(since.Sub(*hotspot.LastIncomeTime) / time.Hour)
+			hoursNow := int(now.Sub(*hotspot.LastIncomeTime) / time.Hour)
+			if hoursNow <= 0 || hoursNow <= hoursBefore {
+				continue
+			}
+
+			newIncome := (hoursNow - hoursBefore) * hotspot.Income
+			accrueIncome(hotspot, now)
+			nextIncomeTime := hotspot.LastIncomeTime.Add(time.Hour)
+
+			// Look up the region once per pass
+			var region *model.Region
+			if regionID, err := s.territoryRepo.GetHotspotRegionID(hotspot.ID); err == nil {
+				if cached, exists := regions[regionID]; exists {
+					region = cached
+				} else if region, err = s.territoryRepo.GetRegionByID(regionID); err == nil {
+					regions[regionID] = region
+				}
+			}
+
+			payload := map[string]interface{}{
+				"id":                hotspot.ID,
+				"name":              hotspot.Name,
+				"newIncome":         newIncome,
+				"pendingCollection": hotspot.PendingCollection,
+				"lastIncomeTime":    hotspot.LastIncomeTime,
+				"nextIncomeTime":    nextIncomeTime,
+			}
+			if region != nil {
+				payload["regionId"] = region.ID
+				payload["regionName"] = region.Name
+			}
+
+			s.sseService.SendEventToPlayer(playerID, "income_generated", map[string]interface{}{
+				"hotspot":   payload,
+				"timestamp": now,
+			})
+
+			// A big payday is worth a notification that outlives the session
+			if threshold := s.gameConfig.Mechanics.Notifications.IncomeThreshold; threshold > 0 && newIncome > threshold {
+				message := fmt.Sprintf("$%s is ready for collection at %s.", formatMoney(newIncome), hotspot.Name)
+				if err := s.addNotification(playerID, message, util.NotificationTypeCollection); err != nil {
+					s.logger.Error().Err(err).Str("playerID", playerID).Msg("Failed to add collection notification")
+				}
+			}
+		}
+	}
+
+	return nil
+}
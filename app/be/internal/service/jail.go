@@ -0,0 +1,225 @@
+// internal/service/jail.go
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"mwce-be/internal/config"
+	"mwce-be/internal/model"
+	"mwce-be/internal/repository"
+	"mwce-be/internal/util"
+
+	"github.com/rs/zerolog"
+)
+
+// JailService handles jailed players and the ways they can get out early
+type JailService interface {
+	GetJailStatus(playerID string) (*model.JailStatus, error)
+	PayBail(playerID string) (*model.JailActionResponse, error)
+	BribeJudge(playerID string) (*model.JailActionResponse, error)
+}
+
+type jailService struct {
+	playerRepo repository.PlayerRepository
+	sseService SSEService
+	gameConfig config.GameConfig
+	logger     zerolog.Logger
+}
+
+// NewJailService creates a new jail service
+func NewJailService(
+	playerRepo repository.PlayerRepository,
+	sseService SSEService,
+	gameConfig config.GameConfig,
+	logger zerolog.Logger,
+) JailService {
+	return &jailService{
+		playerRepo: playerRepo,
+		sseService: sseService,
+		gameConfig: gameConfig,
+		logger:     logger,
+	}
+}
+
+// GetJailStatus shows whether the player is jailed and what an early release costs
+func (s *jailService) GetJailStatus(playerID string) (*model.JailStatus, error) {
+	player, err := s.playerRepo.GetPlayerByID(playerID)
+	if err != nil {
+		return nil, errors.New("player not found")
+	}
+
+	now := time.Now()
+	if !isJailed(player, now) {
+		return &model.JailStatus{Jailed: false}, nil
+	}
+
+	return &model.JailStatus{
+		Jailed:           true,
+		JailedUntil:      player.JailedUntil,
+		RemainingSeconds: int(player.JailedUntil.Sub(now).Seconds()),
+		BailCost:         s.bailCost(player, now),
+		BribeCost:        s.bribeCost(player),
+		BribeChance:      s.bribeChance(player),
+	}, nil
+}
+
+// PayBail releases the player for a price based on the time left on their sentence
+func (s *jailService) PayBail(playerID string) (*model.JailActionResponse, error) {
+	player, err := s.playerRepo.GetPlayerByID(playerID)
+	if err != nil {
+		return nil, errors.New("player not found")
+	}
+
+	now := time.Now()
+	if !isJailed(player, now) {
+		return nil, errors.New("you are not in jail")
+	}
+
+	bail := s.bailCost(player, now)
+	if player.Money < bail {
+		return nil, errors.New("not enough money to pay bail")
+	}
+
+	player.Money -= bail
+	player.JailedUntil = nil
+	if err := s.playerRepo.UpdatePlayer(player); err != nil {
+		s.logger.Error().Err(err).Msg("Failed to update player after paying bail")
+		return nil, errors.New("failed to update player")
+	}
+
+	response := &model.JailActionResponse{
+		Released: true,
+		Cost:     bail,
+		Message:  fmt.Sprintf("You paid $%s in bail and walked out of jail.", formatMoney(bail)),
+	}
+	s.notifyJailOutcome(player.ID, response)
+
+	return response, nil
+}
+
+// BribeJudge tries to buy an early release. Influential players pay less and are more likely to succeed.
+func (s *jailService) BribeJudge(playerID string) (*model.JailActionResponse, error) {
+	player, err := s.playerRepo.GetPlayerByID(playerID)
+	if err != nil {
+		return nil, errors.New("player not found")
+	}
+
+	now := time.Now()
+	if !isJailed(player, now) {
+		return nil, errors.New("you are not in jail")
+	}
+
+	tuning := s.gameConfig.Mechanics.Jail.Bribe
+	cost := s.bribeCost(player)
+	if player.Money < cost {
+		return nil, errors.New("not enough money to bribe the judge")
+	}
+
+	// The money is gone whether or not the judge plays along
+	player.Money -= cost
+	response := &model.JailActionResponse{Cost: cost}
+
+	if rand.Float64()*100 < s.bribeChance(player) {
+		player.JailedUntil = nil
+		response.Released = true
+		response.Message = fmt.Sprintf("The judge accepted your $%s and your case was dismissed.", formatMoney(cost))
+	} else {
+		jailedUntil := player.JailedUntil.Add(time.Duration(tuning.FailureExtraTime) * time.Second)
+		player.JailedUntil = &jailedUntil
+		player.Heat += tuning.FailureHeat
+		response.HeatIncrease = tuning.FailureHeat
+		response.JailedUntil = &jailedUntil
+		response.Message = fmt.Sprintf("The judge took your $%s and reported the attempt. Your sentence has been extended until %s.",
+			formatMoney(cost), jailedUntil.Format(time.Kitchen))
+	}
+
+	if err := s.playerRepo.UpdatePlayer(player); err != nil {
+		s.logger.Error().Err(err).Msg("Failed to update player after bribing the judge")
+		return nil, errors.New("failed to update player")
+	}
+
+	s.notifyJailOutcome(player.ID, response)
+
+	return response, nil
+}
+
+// notifyJailOutcome records a bail or bribe outcome and lets the player's clients know
+func (s *jailService) notifyJailOutcome(playerID string, response *model.JailActionResponse) {
+	notification := &model.Notification{
+		PlayerID:  playerID,
+		Message:   response.Message,
+		Type:      util.NotificationTypeSystem,
+		Timestamp: time.Now(),
+		Read:      false,
+	}
+	if err := s.playerRepo.AddNotification(notification); err != nil {
+		s.logger.Error().Err(err).Msg("Failed to add jail notification")
+	}
+
+	if response.Released {
+		s.sseService.SendEventToPlayer(playerID, "player_released", map[string]interface{}{
+			"playerId":  playerID,
+			"message":   response.Message,
+			"timestamp": time.Now().Format(time.RFC3339),
+		})
+	}
+}
+
+// bailCost prices bail by the minutes left on the sentence
+func (s *jailService) bailCost(player *model.Player, now time.Time) int {
+	tuning := s.gameConfig.Mechanics.Jail
+
+	minutesLeft := int(player.JailedUntil.Sub(now).Minutes()) + 1
+	cost := minutesLeft * tuning.BailPerMinute
+	if cost < tuning.MinBail {
+		cost = tuning.MinBail
+	}
+	return cost
+}
+
+// bribeCost discounts the bribe by the player's influence
+func (s *jailService) bribeCost(player *model.Player) int {
+	tuning := s.gameConfig.Mechanics.Jail.Bribe
+
+	cost := tuning.Cost - player.Influence*tuning.CostPerInfluence
+	if cost < tuning.MinCost {
+		cost = tuning.MinCost
+	}
+	return cost
+}
+
+// bribeChance raises the odds of a successful bribe with the player's influence
+func (s *jailService) bribeChance(player *model.Player) float64 {
+	tuning := s.gameConfig.Mechanics.Jail.Bribe
+
+	chance := tuning.BaseChance + float64(player.Influence)*tuning.ChancePerInfluence
+	if chance > tuning.MaxChance {
+		chance = tuning.MaxChance
+	}
+	return chance
+}
+
+// rollArrest decides whether a police stop ends in arrest and, if so, jails the player.
+// It returns the end of the sentence, or nil when the player was let go.
+func rollArrest(tuning config.JailConfig, player *model.Player, now time.Time) *time.Time {
+	chance := tuning.ArrestChance + float64(player.Heat)*tuning.ArrestHeatMultiplier
+	if chance > tuning.MaxArrestChance {
+		chance = tuning.MaxArrestChance
+	}
+	if rand.Float64()*100 >= chance {
+		return nil
+	}
+
+	seconds := tuning.Duration + player.Heat*tuning.DurationPerHeat
+	if tuning.MaxDuration > 0 && seconds > tuning.MaxDuration {
+		seconds = tuning.MaxDuration
+	}
+
+	jailedUntil := now.Add(time.Duration(seconds) * time.Second)
+	player.JailedUntil = &jailedUntil
+	return &jailedUntil
+}
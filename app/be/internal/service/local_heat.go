@@ -0,0 +1,152 @@
+// internal/service/local_heat.go
+
+package service
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"mwce-be/internal/config"
+	"mwce-be/internal/model"
+	"mwce-be/internal/repository"
+	"mwce-be/internal/util"
+)
+
+// addLocalHeat spreads the heat an action generated onto the hotspot's city and region,
+// and sets off a crackdown when the city gets too hot
+func (s *territoryService) addLocalHeat(hotspot *model.Hotspot, heatGenerated int) {
+	tuning := s.gameConfig.Mechanics.LocalHeat
+	cityAmount := heatGenerated * tuning.ActionShare / 100
+	if cityAmount <= 0 {
+		return
+	}
+
+	regionID, err := s.territoryRepo.GetHotspotRegionID(hotspot.ID)
+	if err != nil {
+		s.logger.Error().Err(err).Str("hotspotID", hotspot.ID).Msg("Failed to get region for local heat")
+	}
+	regionAmount := cityAmount * tuning.RegionShare / 100
+
+	cityHeat, err := s.territoryRepo.AddLocalHeat(hotspot.CityID, regionID, cityAmount, regionAmount, tuning.MaxHeat)
+	if err != nil {
+		s.logger.Error().Err(err).Str("cityID", hotspot.CityID).Msg("Failed to add local heat")
+		return
+	}
+
+	if tuning.Crackdown.Threshold > 0 && cityHeat >= tuning.Crackdown.Threshold {
+		s.startCrackdown(hotspot.CityID)
+	}
+}
+
+// addRegionHeat spreads the heat of something that happened out on the roads or across a region,
+// rather than in one city, onto the regions involved. They take the share a region gets of a city's heat.
+func addRegionHeat(territoryRepo repository.TerritoryRepository, tuning config.LocalHeatConfig, regionIDs []string, heatGenerated int) error {
+	amount := heatGenerated * tuning.ActionShare / 100 * tuning.RegionShare / 100
+	if amount <= 0 || len(regionIDs) == 0 {
+		return nil
+	}
+	return territoryRepo.AddRegionHeat(regionIDs, amount, tuning.MaxHeat)
+}
+
+// addOperationLocalHeat spreads the heat a failed operation drew onto the regions it ran in,
+// or onto the player's current region when the operation is not tied to any
+func (s *operationsService) addOperationLocalHeat(operation *model.Operation, playerID string, heatGenerated int) {
+	if heatGenerated <= 0 {
+		return
+	}
+
+	regionIDs := []string(operation.RegionIDs)
+	if len(regionIDs) == 0 {
+		player, err := s.playerRepo.GetPlayerByID(playerID)
+		if err != nil || player.CurrentRegionID == nil {
+			return
+		}
+		regionIDs = []string{*player.CurrentRegionID}
+	}
+
+	if err := addRegionHeat(s.territoryRepo, s.gameConfig.Mechanics.LocalHeat, regionIDs, heatGenerated); err != nil {
+		s.logger.Error().Err(err).Str("operationID", operation.ID).Msg("Failed to add local heat for operation")
+	}
+}
+
+// startCrackdown freezes a city's illegal businesses unless a crackdown is already running there
+func (s *territoryService) startCrackdown(cityID string) {
+	tuning := s.gameConfig.Mechanics.LocalHeat.Crackdown
+	now := time.Now()
+	until := now.Add(time.Duration(tuning.Duration) * time.Second)
+
+	started, err := s.territoryRepo.StartCityCrackdown(cityID, until, tuning.HeatRelief)
+	if err != nil {
+		s.logger.Error().Err(err).Str("cityID", cityID).Msg("Failed to start crackdown")
+		return
+	}
+	if !started {
+		return
+	}
+
+	cityName := ""
+	if city, err := s.territoryRepo.GetCityByID(cityID); err == nil {
+		cityName = city.Name
+	}
+
+	s.logger.Info().
+		Str("cityID", cityID).
+		Time("until", until).
+		Msg("Police crackdown started")
+
+	s.sseService.SendEventToAll("city_crackdown", map[string]interface{}{
+		"cityId":    cityID,
+		"cityName":  cityName,
+		"until":     until,
+		"message":   fmt.Sprintf("Police are cracking down on %s. Its illegal businesses are shut until %s.", cityName, until.Format(time.Kitchen)),
+		"timestamp": now.Format(time.RFC3339),
+	})
+}
+
+// ProcessLocalHeatDecay cools every city and region down
+func (s *territoryService) ProcessLocalHeatDecay() error {
+	amount := s.gameConfig.Mechanics.LocalHeat.DecayAmount
+	if amount <= 0 {
+		return nil
+	}
+	return s.territoryRepo.DecayLocalHeat(amount)
+}
+
+// underCrackdown reports whether police have shut down the city's illegal businesses
+func underCrackdown(city *model.City, now time.Time) bool {
+	return city.CrackdownUntil != nil && city.CrackdownUntil.After(now)
+}
+
+// localHeatPayoutPenalty returns the percentage a city's heat takes off extortion payouts
+func (s *territoryService) localHeatPayoutPenalty(city *model.City) int {
+	tuning := s.gameConfig.Mechanics.LocalHeat
+	penalty := int(math.Round(float64(city.Heat) * tuning.PayoutPenaltyPerHeat))
+	if penalty > tuning.MaxPayoutPenalty {
+		penalty = tuning.MaxPayoutPenalty
+	}
+	return penalty
+}
+
+// localHeatChanceModifier makes territory actions harder in cities the police are watching
+func (s *territoryService) localHeatChanceModifier(action *chanceAction) *model.SuccessModifier {
+	city := action.City
+	if city == nil {
+		return nil
+	}
+
+	tuning := s.gameConfig.Mechanics.LocalHeat
+	penalty := int(math.Round(float64(city.Heat) * tuning.ActionPenaltyPerHeat))
+	if penalty > tuning.MaxActionPenalty {
+		penalty = tuning.MaxActionPenalty
+	}
+	if penalty == 0 {
+		return nil
+	}
+
+	return &model.SuccessModifier{
+		Source: util.SuccessModifierLocalHeat,
+		Label:  fmt.Sprintf("Police presence in %s (heat %d)", city.Name, city.Heat),
+		Value:  -penalty,
+	}
+}
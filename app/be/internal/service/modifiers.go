@@ -0,0 +1,75 @@
+// internal/service/modifiers.go
+
+package service
+
+import (
+	"fmt"
+
+	"mwce-be/internal/config"
+	"mwce-be/internal/model"
+	"mwce-be/internal/util"
+)
+
+// Success chances are always kept between these bounds
+const (
+	minSuccessChance = 5
+	maxSuccessChance = 95
+)
+
+// newSuccessBreakdown starts a breakdown from a base chance with the standard bounds
+func newSuccessBreakdown(baseChance int) *model.SuccessBreakdown {
+	return &model.SuccessBreakdown{
+		BaseChance: baseChance,
+		Modifiers:  []model.SuccessModifier{},
+		MinChance:  minSuccessChance,
+		MaxChance:  maxSuccessChance,
+	}
+}
+
+// modifierTotal adds up the modifiers a breakdown applied from one source
+func modifierTotal(breakdown *model.SuccessBreakdown, source string) int {
+	total := 0
+	for _, modifier := range breakdown.Modifiers {
+		if modifier.Source == source {
+			total += modifier.Value
+		}
+	}
+	return total
+}
+
+// heatPenalty finds the penalty for the highest police response threshold a heat level has reached.
+// It returns the penalty and the threshold, both zero when no threshold applies.
+func heatPenalty(mechanics *config.MechanicsConfig, effect string, heat int) (int, int) {
+	if mechanics == nil {
+		return 0, 0
+	}
+
+	penalties, exists := mechanics.Heat.Effects[util.HeatEffectPoliceResponse][effect]
+	if !exists {
+		return 0, 0
+	}
+
+	penalty, appliedThreshold := 0, 0
+	for threshold, value := range penalties {
+		if heat >= threshold && threshold > appliedThreshold {
+			appliedThreshold = threshold
+			penalty = value
+		}
+	}
+
+	return penalty, appliedThreshold
+}
+
+// heatPenaltyModifier turns the police response heat penalty into a success modifier, or nil when none applies
+func heatPenaltyModifier(mechanics *config.MechanicsConfig, effect string, heat int) *model.SuccessModifier {
+	penalty, threshold := heatPenalty(mechanics, effect, heat)
+	if penalty == 0 {
+		return nil
+	}
+
+	return &model.SuccessModifier{
+		Source: util.SuccessModifierHeat,
+		Label:  fmt.Sprintf("Police attention (heat %d+)", threshold),
+		Value:  -penalty,
+	}
+}
@@ -0,0 +1,323 @@
+// internal/service/neighborhood.go
+
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"mwce-be/internal/config"
+	"mwce-be/internal/model"
+	"mwce-be/internal/repository"
+	"mwce-be/internal/util"
+
+	"github.com/rs/zerolog"
+)
+
+// NeighborhoodService works out the bonuses players earn for controlling many hotspots in one area
+type NeighborhoodService interface {
+	GetPlayerBonuses(playerID string) ([]model.NeighborhoodBonus, error)
+	GetCityBonuses(cityID string) ([]model.NeighborhoodBonus, error)
+	GetHotspotBonuses(hotspot *model.Hotspot) ([]model.NeighborhoodBonus, error)
+	GetBonusMap() (*NeighborhoodBonusMap, error)
+	GrantInfluence() error
+
+	// Scheduled jobs
+	StartPeriodicInfluenceGrants()
+}
+
+type neighborhoodService struct {
+	territoryRepo repository.TerritoryRepository
+	playerRepo    repository.PlayerRepository
+	gameConfig    config.GameConfig
+	logger        zerolog.Logger
+}
+
+// NeighborhoodBonusMap holds every bonus active across the map at one moment,
+// so a pass over many hotspots can look them up without recounting control each time
+type NeighborhoodBonusMap struct {
+	bonuses []model.NeighborhoodBonus
+	cities  map[string]cityArea
+}
+
+// cityArea locates a city within its district and region
+type cityArea struct {
+	DistrictID string
+	RegionID   string
+}
+
+// areaControl tallies how many legal hotspots each controller holds in one area
+type areaControl struct {
+	Name        string
+	Total       int
+	Controlled  map[string]int
+	PlayerNames map[string]string
+}
+
+// NewNeighborhoodService creates a new neighborhood service
+func NewNeighborhoodService(
+	territoryRepo repository.TerritoryRepository,
+	playerRepo repository.PlayerRepository,
+	gameConfig config.GameConfig,
+	logger zerolog.Logger,
+) NeighborhoodService {
+	return &neighborhoodService{
+		territoryRepo: territoryRepo,
+		playerRepo:    playerRepo,
+		gameConfig:    gameConfig,
+		logger:        logger,
+	}
+}
+
+// GetPlayerBonuses retrieves every neighborhood bonus a player currently holds
+func (s *neighborhoodService) GetPlayerBonuses(playerID string) ([]model.NeighborhoodBonus, error) {
+	bonusMap, err := s.GetBonusMap()
+	if err != nil {
+		return nil, err
+	}
+
+	return bonusMap.PlayerBonuses(playerID), nil
+}
+
+// GetCityBonuses retrieves the bonuses that cover a city, including district and region wide ones
+func (s *neighborhoodService) GetCityBonuses(cityID string) ([]model.NeighborhoodBonus, error) {
+	bonusMap, err := s.GetBonusMap()
+	if err != nil {
+		return nil, err
+	}
+
+	return bonusMap.CityBonuses(cityID), nil
+}
+
+// GetHotspotBonuses retrieves the bonuses the hotspot's controller holds over the hotspot's area
+func (s *neighborhoodService) GetHotspotBonuses(hotspot *model.Hotspot) ([]model.NeighborhoodBonus, error) {
+	if hotspot.ControllerID == nil || !hotspot.IsLegal {
+		return []model.NeighborhoodBonus{}, nil
+	}
+
+	bonusMap, err := s.GetBonusMap()
+	if err != nil {
+		return nil, err
+	}
+
+	return bonusMap.HotspotBonuses(hotspot), nil
+}
+
+// GetBonusMap works out every bonus currently held across the map in a single pass
+func (s *neighborhoodService) GetBonusMap() (*NeighborhoodBonusMap, error) {
+	bonuses, cities, err := s.activeBonuses()
+	if err != nil {
+		return nil, err
+	}
+
+	return &NeighborhoodBonusMap{bonuses: bonuses, cities: cities}, nil
+}
+
+// GrantInfluence gives every player the influence their neighborhood bonuses earn per interval
+func (s *neighborhoodService) GrantInfluence() error {
+	bonuses, _, err := s.activeBonuses()
+	if err != nil {
+		return err
+	}
+
+	// Influence is configured per hour, so scale it to the grant interval
+	hours := float64(s.gameConfig.Mechanics.Neighborhood.InfluenceInterval) / 60.0
+
+	grants := make(map[string]int)
+	for _, bonus := range bonuses {
+		grants[bonus.PlayerID] += int(float64(bonus.InfluencePerHour) * hours)
+	}
+
+	for playerID, influence := range grants {
+		if influence <= 0 {
+			continue
+		}
+
+		if err := s.playerRepo.UpdatePlayerResource(playerID, util.ResourceTypeInfluence, influence); err != nil {
+			s.logger.Error().Err(err).Str("playerID", playerID).Msg("Failed to grant neighborhood influence")
+			continue
+		}
+
+		notification := &model.Notification{
+			PlayerID:  playerID,
+			Message:   fmt.Sprintf("Your neighborhood control earned you %d influence.", influence),
+			Type:      util.NotificationTypeTerritory,
+			Timestamp: time.Now(),
+			Read:      false,
+		}
+		if err := s.playerRepo.AddNotification(notification); err != nil {
+			s.logger.Error().Err(err).Msg("Failed to add neighborhood influence notification")
+		}
+	}
+
+	return nil
+}
+
+// activeBonuses works out every bonus currently held across the map, along with where each city sits
+func (s *neighborhoodService) activeBonuses() ([]model.NeighborhoodBonus, map[string]cityArea, error) {
+	counts, err := s.territoryRepo.GetLegalHotspotControlCounts()
+	if err != nil {
+		s.logger.Error().Err(err).Msg("Failed to get hotspot control counts")
+		return nil, nil, err
+	}
+
+	cities := make(map[string]cityArea)
+	areas := map[string]map[string]*areaControl{
+		util.NeighborhoodScopeCity:     {},
+		util.NeighborhoodScopeDistrict: {},
+		util.NeighborhoodScopeRegion:   {},
+	}
+
+	// Tally control for each city, district and region
+	for _, count := range counts {
+		cities[count.CityID] = cityArea{DistrictID: count.DistrictID, RegionID: count.RegionID}
+
+		scopes := map[string][2]string{
+			util.NeighborhoodScopeCity:     {count.CityID, count.CityName},
+			util.NeighborhoodScopeDistrict: {count.DistrictID, count.DistrictName},
+			util.NeighborhoodScopeRegion:   {count.RegionID, count.RegionName},
+		}
+		for scope, area := range scopes {
+			control, exists := areas[scope][area[0]]
+			if !exists {
+				control = &areaControl{Name: area[1], Controlled: map[string]int{}, PlayerNames: map[string]string{}}
+				areas[scope][area[0]] = control
+			}
+
+			control.Total += count.Hotspots
+			if count.ControllerID != nil {
+				control.Controlled[*count.ControllerID] += count.Hotspots
+				if count.ControllerName != nil {
+					control.PlayerNames[*count.ControllerID] = *count.ControllerName
+				}
+			}
+		}
+	}
+
+	// Award the first tier each controller reaches in each area
+	bonuses := make([]model.NeighborhoodBonus, 0)
+	for scope, scopeAreas := range areas {
+		for areaID, control := range scopeAreas {
+			for playerID, controlled := range control.Controlled {
+				tier := s.qualifyingTier(scope, controlled, control.Total)
+				if tier == nil {
+					continue
+				}
+
+				incomeMultiplier := tier.IncomeMultiplier
+				if incomeMultiplier == 0 {
+					incomeMultiplier = 1
+				}
+
+				bonuses = append(bonuses, model.NeighborhoodBonus{
+					TierID:           tier.ID,
+					Name:             tier.Name,
+					Scope:            scope,
+					AreaID:           areaID,
+					AreaName:         control.Name,
+					PlayerID:         playerID,
+					PlayerName:       control.PlayerNames[playerID],
+					Controlled:       controlled,
+					Total:            control.Total,
+					IncomeMultiplier: incomeMultiplier,
+					DefenseBonus:     tier.DefenseBonus,
+					InfluencePerHour: tier.InfluencePerHour,
+				})
+			}
+		}
+	}
+
+	return bonuses, cities, nil
+}
+
+// PlayerBonuses filters the map to the bonuses one player holds
+func (m *NeighborhoodBonusMap) PlayerBonuses(playerID string) []model.NeighborhoodBonus {
+	playerBonuses := make([]model.NeighborhoodBonus, 0)
+	for _, bonus := range m.bonuses {
+		if bonus.PlayerID == playerID {
+			playerBonuses = append(playerBonuses, bonus)
+		}
+	}
+
+	return playerBonuses
+}
+
+// CityBonuses filters the map to the bonuses that cover a city
+func (m *NeighborhoodBonusMap) CityBonuses(cityID string) []model.NeighborhoodBonus {
+	return bonusesCoveringCity(m.bonuses, m.cities, cityID, "")
+}
+
+// HotspotBonuses filters the map to the bonuses the hotspot's controller holds over the hotspot's area
+func (m *NeighborhoodBonusMap) HotspotBonuses(hotspot *model.Hotspot) []model.NeighborhoodBonus {
+	if hotspot.ControllerID == nil || !hotspot.IsLegal {
+		return []model.NeighborhoodBonus{}
+	}
+
+	return bonusesCoveringCity(m.bonuses, m.cities, hotspot.CityID, *hotspot.ControllerID)
+}
+
+// qualifyingTier finds the first tier in a scope that the controlled share reaches
+func (s *neighborhoodService) qualifyingTier(scope string, controlled, total int) *config.NeighborhoodBonusTier {
+	tiers := s.gameConfig.Mechanics.Neighborhood.Tiers
+	for i := range tiers {
+		tier := &tiers[i]
+		if tier.Scope != scope || controlled < tier.MinControlled {
+			continue
+		}
+		if controlled*100 < tier.MinPercent*total {
+			continue
+		}
+		return tier
+	}
+	return nil
+}
+
+// bonusesCoveringCity filters bonuses to those whose area contains the city, optionally for one player
+func bonusesCoveringCity(bonuses []model.NeighborhoodBonus, cities map[string]cityArea, cityID, playerID string) []model.NeighborhoodBonus {
+	area, exists := cities[cityID]
+
+	covering := make([]model.NeighborhoodBonus, 0)
+	for _, bonus := range bonuses {
+		if playerID != "" && bonus.PlayerID != playerID {
+			continue
+		}
+
+		switch bonus.Scope {
+		case util.NeighborhoodScopeCity:
+			if bonus.AreaID != cityID {
+				continue
+			}
+		case util.NeighborhoodScopeDistrict:
+			if !exists || bonus.AreaID != area.DistrictID {
+				continue
+			}
+		case util.NeighborhoodScopeRegion:
+			if !exists || bonus.AreaID != area.RegionID {
+				continue
+			}
+		}
+
+		covering = append(covering, bonus)
+	}
+
+	return covering
+}
+
+// neighborhoodDefenseBonus adds up the defense bonuses of a set of bonuses
+func neighborhoodDefenseBonus(bonuses []model.NeighborhoodBonus) int {
+	total := 0
+	for _, bonus := range bonuses {
+		total += bonus.DefenseBonus
+	}
+	return total
+}
+
+// hasNeighborhoodBonus reports whether a set of bonuses includes a tier
+func hasNeighborhoodBonus(bonuses []model.NeighborhoodBonus, tierID string) bool {
+	for _, bonus := range bonuses {
+		if bonus.TierID == tierID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,24 @@
+package service
+
+import "time"
+
+// StartPeriodicInfluenceGrants starts a goroutine that periodically grants influence from neighborhood bonuses
+func (s *neighborhoodService) StartPeriodicInfluenceGrants() {
+	if len(s.gameConfig.Mechanics.Neighborhood.Tiers) == 0 {
+		s.logger.Info().Msg("No neighborhood bonus tiers configured, influence grants disabled")
+		return
+	}
+
+	grantInterval := time.Duration(s.gameConfig.Mechanics.Neighborhood.InfluenceInterval) * time.Minute
+	ticker := time.NewTicker(grantInterval)
+
+	go func() {
+		for range ticker.C {
+			if err := s.GrantInfluence(); err != nil {
+				s.logger.Error().Err(err).Msg("Failed to grant neighborhood influence")
+			}
+		}
+	}()
+
+	s.logger.Info().Dur("interval", grantInterval).Msg("Started neighborhood influence scheduler")
+}
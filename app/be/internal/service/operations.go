@@ -5,9 +5,9 @@ package service
 import (
 	"errors"
 	"fmt"
+	"math"
 	"math/rand"
 	"mwce-be/internal/util"
-	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -47,6 +47,7 @@ type operationsService struct {
 	territoryRepo             repository.TerritoryRepository
 	playerRepo                repository.PlayerRepository
 	playerService             PlayerService
+	neighborhoodService       NeighborhoodService
 	sseService                SSEService
 	gameConfig                config.GameConfig
 	logger                    zerolog.Logger
@@ -62,6 +63,7 @@ func NewOperationsService(
 	territoryRepo repository.TerritoryRepository,
 	playerRepo repository.PlayerRepository,
 	playerService PlayerService,
+	neighborhoodService NeighborhoodService,
 	sseService SSEService,
 	gameConfig config.GameConfig,
 	logger zerolog.Logger,
@@ -72,6 +74,7 @@ func NewOperationsService(
 		territoryRepo:             territoryRepo,
 		playerRepo:                playerRepo,
 		playerService:             playerService,
+		neighborhoodService:       neighborhoodService,
 		sseService:                sseService,
 		gameConfig:                gameConfig,
 		logger:                    logger,
@@ -147,6 +150,8 @@ func (s *operationsService) GetAvailableOperations(playerID string, validOnly bo
 // New helper function to filter operations and mark them as locked if they don't meet requirements
 func (s *operationsService) filterOperationsByRequirements(operations []model.Operation, player *model.Player) []model.Operation {
 	var filteredOps []model.Operation
+	var bonuses []model.NeighborhoodBonus
+	bonusesLoaded := false
 
 	for _, op := range operations {
 		opCopy := op // Create a copy to avoid modifying the original
@@ -175,6 +180,23 @@ func (s *operationsService) filterOperationsByRequirements(operations []model.Op
 				opCopy.LockReason = fmt.Sprintf("Requires %s rank (you are %s)",
 					op.Requirements.MinTitle, player.Title)
 			}
+
+			// Check neighborhood bonus
+			if op.Requirements.RequiredBonus != "" {
+				if !bonusesLoaded {
+					var err error
+					if bonuses, err = s.neighborhoodService.GetPlayerBonuses(player.ID); err != nil {
+						s.logger.Error().Err(err).Msg("Failed to get neighborhood bonuses for player")
+					}
+					bonusesLoaded = true
+				}
+
+				if !hasNeighborhoodBonus(bonuses, op.Requirements.RequiredBonus) {
+					opCopy.IsLocked = true
+					opCopy.LockReason = fmt.Sprintf("Requires the %s neighborhood bonus",
+						s.neighborhoodTierName(op.Requirements.RequiredBonus))
+				}
+			}
 		}
 
 		filteredOps = append(filteredOps, opCopy)
@@ -346,6 +368,11 @@ func (s *operationsService) StartOperation(playerID, operationID string, resourc
 		return nil, errors.New("player not found")
 	}
 
+	// Check the player is in a state to act
+	if err := checkPlayerCanAct(player); err != nil {
+		return nil, err
+	}
+
 	// Check if the operation would be locked for this player
 	if operation.IsSpecial {
 		if operation.Requirements.MinInfluence > 0 && player.Influence < operation.Requirements.MinInfluence {
@@ -362,6 +389,17 @@ func (s *operationsService) StartOperation(playerID, operationID string, resourc
 			return nil, fmt.Errorf("your title rank is too low for this operation (requires %s, you are %s)",
 				operation.Requirements.MinTitle, player.Title)
 		}
+
+		if operation.Requirements.RequiredBonus != "" {
+			bonuses, err := s.neighborhoodService.GetPlayerBonuses(playerID)
+			if err != nil {
+				return nil, errors.New("failed to check neighborhood bonuses")
+			}
+			if !hasNeighborhoodBonus(bonuses, operation.Requirements.RequiredBonus) {
+				return nil, fmt.Errorf("this operation requires the %s neighborhood bonus",
+					s.neighborhoodTierName(operation.Requirements.RequiredBonus))
+			}
+		}
 	}
 
 	// Check if the player already has this operation in progress
@@ -559,14 +597,16 @@ func (s *operationsService) CollectOperation(playerID, attemptID string) (*model
 	}
 
 	// Determine success or failure
-	successChance := s.calculateSuccessChance(operation, attempt.Resources, playerID)
-	success := rand.Float64()*100 < float64(successChance)
+	breakdown := s.calculateSuccessChance(operation, attempt.Resources, playerID)
+	success := rand.Float64()*100 < float64(breakdown.FinalChance)
 
 	// Generate result without applying rewards (this will be done in CollectOperationReward)
 	result := &model.OperationResult{
 		Success:          success,
 		Message:          "",
 		RewardsCollected: false,
+		PityBonus:        modifierTotal(breakdown, util.SuccessModifierPity),
+		Breakdown:        breakdown,
 	}
 
 	// Process potential outcomes based on success without applying them
@@ -653,6 +693,12 @@ func (s *operationsService) CollectOperation(playerID, attemptID string) (*model
 		s.playerRepo.UpdatePlayerStats(stats)
 	}
 
+	// Extend or reset the operation failure streak
+	recordPityOutcome(s.playerRepo, s.logger, playerID, util.PityCategoryOperations, success)
+
+	// The police remember where it went wrong
+	s.addOperationLocalHeat(operation, playerID, result.HeatGenerated)
+
 	// Add notification
 	s.playerService.AddNotification(playerID, result.Message, util.NotificationTypeOperation)
 
@@ -886,14 +932,16 @@ func (s *operationsService) CheckAndCompleteOperations() error {
 		timeSinceStart := now.Sub(attempt.Timestamp)
 		if timeSinceStart.Seconds() >= float64(operation.Duration) {
 			// Determine success or failure
-			successChance := s.calculateSuccessChance(operation, attempt.Resources, attempt.PlayerID)
-			success := rand.Float64()*100 < float64(successChance)
+			breakdown := s.calculateSuccessChance(operation, attempt.Resources, attempt.PlayerID)
+			success := rand.Float64()*100 < float64(breakdown.FinalChance)
 
 			// Generate result without applying rewards (this will be done in CollectOperationReward)
 			result := &model.OperationResult{
 				Success:          success,
 				Message:          "",
 				RewardsCollected: false,
+				PityBonus:        modifierTotal(breakdown, util.SuccessModifierPity),
+				Breakdown:        breakdown,
 			}
 
 			// Calculate potential rewards or losses without applying them
@@ -980,6 +1028,12 @@ func (s *operationsService) CheckAndCompleteOperations() error {
 				s.playerRepo.UpdatePlayerStats(stats)
 			}
 
+			// Extend or reset the operation failure streak
+			recordPityOutcome(s.playerRepo, s.logger, attempt.PlayerID, util.PityCategoryOperations, success)
+
+			// The police remember where it went wrong
+			s.addOperationLocalHeat(operation, attempt.PlayerID, result.HeatGenerated)
+
 			// Add notification
 			notificationMsg := fmt.Sprintf("Operation '%s' is ready to collect!", operation.Name)
 			s.playerService.AddNotification(attempt.PlayerID, notificationMsg, util.NotificationTypeOperation)
@@ -1003,6 +1057,13 @@ func (s *operationsService) RefreshDailyOperations() error {
 		return fmt.Errorf("failed to load operations data: %w", err)
 	}
 
+	// Apply templates edited through the admin API
+	templates, err := s.operationsRepo.GetOperationTemplates()
+	if err != nil {
+		return fmt.Errorf("failed to load operation templates: %w", err)
+	}
+	applyOperationTemplates(operationsData, templates)
+
 	// Get all regions for multi-region support
 	var regions []model.Region
 	if err := s.operationsRepo.GetDB().Find(&regions).Error; err != nil {
@@ -1232,162 +1293,92 @@ func (s *operationsService) RefreshDailyOperations() error {
 	return nil
 }
 
-// calculateSuccessChance calculates the success chance for an operation
-func (s *operationsService) calculateSuccessChance(operation *model.Operation, resources model.OperationResources, playerID string) int {
-	// If mechanics config is available, use it for calculations
-	if s.gameConfig.Mechanics != nil {
-		// Try to get operation-specific success chance from config
-		if successChanceConfig, exists := s.gameConfig.Mechanics.SuccessChances[operation.Type]; exists {
-			// Base success chance from config
-			successChance := successChanceConfig.BaseChance
-
-			// Apply resource multipliers from config
-			resourceCommitmentBonus := 0.0
-			if len(successChanceConfig.ResourceMultiplier) > 0 {
-				// Calculate resource commitment level
-				if multiplier, exists := successChanceConfig.ResourceMultiplier["crew"]; exists && operation.Resources.Crew > 0 {
-					crewCommitment := float64(resources.Crew) / float64(operation.Resources.Crew) * multiplier
-					resourceCommitmentBonus += crewCommitment
-				}
-
-				if multiplier, exists := successChanceConfig.ResourceMultiplier["weapons"]; exists && operation.Resources.Weapons > 0 {
-					weaponsCommitment := float64(resources.Weapons) / float64(operation.Resources.Weapons) * multiplier
-					resourceCommitmentBonus += weaponsCommitment
-				}
+// calculateSuccessChance calculates the success chance for an operation, itemizing every modifier applied
+func (s *operationsService) calculateSuccessChance(operation *model.Operation, resources model.OperationResources, playerID string) *model.SuccessBreakdown {
+	var breakdown *model.SuccessBreakdown
 
-				if multiplier, exists := successChanceConfig.ResourceMultiplier["vehicles"]; exists && operation.Resources.Vehicles > 0 {
-					vehiclesCommitment := float64(resources.Vehicles) / float64(operation.Resources.Vehicles) * multiplier
-					resourceCommitmentBonus += vehiclesCommitment
-				}
+	// Try to get operation-specific success chance from config
+	var successChanceConfig config.SuccessChance
+	configured := false
+	if s.gameConfig.Mechanics != nil {
+		successChanceConfig, configured = s.gameConfig.Mechanics.SuccessChances[operation.Type]
+	}
 
-				if multiplier, exists := successChanceConfig.ResourceMultiplier["money"]; exists && operation.Resources.Money > 0 {
-					moneyCommitment := float64(resources.Money) / float64(operation.Resources.Money) * multiplier
-					resourceCommitmentBonus += moneyCommitment
-				}
-			}
+	if configured {
+		// Base success chance from config
+		breakdown = newSuccessBreakdown(successChanceConfig.BaseChance)
 
-			// Apply resource commitment bonus
-			successChance += int(resourceCommitmentBonus)
-
-			// Apply heat penalty if applicable
-			if s.gameConfig.Mechanics.Heat.Effects != nil {
-				// Check for operation success penalties in heat effects
-				operationPenalties, exists := s.gameConfig.Mechanics.Heat.Effects["operation_success_penalty"]
-				if exists && playerID != "" {
-					// Get player's current heat
-					player, err := s.playerRepo.GetPlayerByID(playerID)
-					if err == nil {
-						// Find the appropriate penalty tier based on heat level
-						// We need to process the nested map structure correctly
-						var appliedPenalty int
-						highestApplicableThreshold := 0
-
-						// Find the highest threshold that's less than or equal to player's heat
-						for heatThresholdStr, penaltyVal := range operationPenalties {
-							// Convert string key to int for comparison
-							heatThreshold, err := strconv.Atoi(heatThresholdStr)
-							if err != nil {
-								s.logger.Warn().
-									Str("threshold", heatThresholdStr).
-									Msg("Invalid heat threshold format in config")
-								continue
-							}
-
-							// If player heat meets or exceeds this threshold and it's higher than previous matches
-							if player.Heat >= heatThreshold && heatThreshold > highestApplicableThreshold {
-								highestApplicableThreshold = heatThreshold
-
-								// Extract the penalty value
-								penalty, exists := penaltyVal[highestApplicableThreshold]
-								if !exists {
-									s.logger.Warn().
-										Int("threshold", highestApplicableThreshold).
-										Msg("Penalty not found for threshold in config")
-									continue
-								}
-								appliedPenalty = penalty
-							}
-						}
-
-						// Apply the highest applicable penalty
-						if highestApplicableThreshold > 0 {
-							successChance -= appliedPenalty
-							s.logger.Debug().
-								Int("playerHeat", player.Heat).
-								Int("thresholdApplied", highestApplicableThreshold).
-								Int("penaltyApplied", appliedPenalty).
-								Int("finalSuccessChance", successChance).
-								Msg("Applied heat penalty to operation")
-						}
-					}
-				}
-			}
+		// Apply resource multipliers from config
+		resourceCommitmentBonus := 0.0
+		if multiplier, exists := successChanceConfig.ResourceMultiplier["crew"]; exists && operation.Resources.Crew > 0 {
+			resourceCommitmentBonus += float64(resources.Crew) / float64(operation.Resources.Crew) * multiplier
+		}
+		if multiplier, exists := successChanceConfig.ResourceMultiplier["weapons"]; exists && operation.Resources.Weapons > 0 {
+			resourceCommitmentBonus += float64(resources.Weapons) / float64(operation.Resources.Weapons) * multiplier
+		}
+		if multiplier, exists := successChanceConfig.ResourceMultiplier["vehicles"]; exists && operation.Resources.Vehicles > 0 {
+			resourceCommitmentBonus += float64(resources.Vehicles) / float64(operation.Resources.Vehicles) * multiplier
+		}
+		if multiplier, exists := successChanceConfig.ResourceMultiplier["money"]; exists && operation.Resources.Money > 0 {
+			resourceCommitmentBonus += float64(resources.Money) / float64(operation.Resources.Money) * multiplier
+		}
+		breakdown.Add(&model.SuccessModifier{
+			Source: util.SuccessModifierStrength,
+			Label:  "Resources committed",
+			Value:  int(resourceCommitmentBonus),
+		})
 
-			// Cap success chance between 5% and 95%
-			if successChance < 5 {
-				successChance = 5
-			} else if successChance > 95 {
-				successChance = 95
+		// Apply heat penalty if applicable
+		if playerID != "" {
+			if player, err := s.playerRepo.GetPlayerByID(playerID); err == nil {
+				breakdown.Add(heatPenaltyModifier(s.gameConfig.Mechanics, util.HeatEffectOperationSuccessPenalty, player.Heat))
 			}
-
-			return successChance
 		}
+	} else {
+		// Fallback to the operation's own success rate when it has no configured chance
+		breakdown = newSuccessBreakdown(operation.SuccessRate)
+		breakdown.Add(s.commitmentModifier(operation, resources))
 	}
 
-	// Fallback to original calculation if config not available
-	// Base success chance from the operation
-	successChance := operation.SuccessRate
-
-	// Calculate resource commitment level (0-100%)
-	requiredCrew := operation.Resources.Crew
-	requiredWeapons := operation.Resources.Weapons
-	requiredVehicles := operation.Resources.Vehicles
-
-	// Avoid division by zero
-	crewCommitment := 100.0
-	if requiredCrew > 0 {
-		crewCommitment = float64(resources.Crew) / float64(requiredCrew) * 100.0
-		if crewCommitment > 200.0 {
-			crewCommitment = 200.0 // Cap at 200%
-		}
+	// Consecutive failures earn a growing bonus, whichever way the chance was worked out
+	if playerID != "" {
+		breakdown.Add(s.operationPityModifier(playerID))
 	}
 
-	weaponsCommitment := 100.0
-	if requiredWeapons > 0 {
-		weaponsCommitment = float64(resources.Weapons) / float64(requiredWeapons) * 100.0
-		if weaponsCommitment > 200.0 {
-			weaponsCommitment = 200.0 // Cap at 200%
-		}
-	}
+	breakdown.Resolve()
+	return breakdown
+}
 
-	vehiclesCommitment := 100.0
-	if requiredVehicles > 0 {
-		vehiclesCommitment = float64(resources.Vehicles) / float64(requiredVehicles) * 100.0
-		if vehiclesCommitment > 200.0 {
-			vehiclesCommitment = 200.0 // Cap at 200%
+// commitmentModifier compares the committed resources against what the operation requires, capped at 200% each
+func (s *operationsService) commitmentModifier(operation *model.Operation, resources model.OperationResources) *model.SuccessModifier {
+	commitment := func(committed, required int) float64 {
+		if required <= 0 {
+			return 100.0 // Avoid division by zero
 		}
+		return math.Min(float64(committed)/float64(required)*100.0, 200.0)
 	}
 
 	// Weight the commitment levels
-	averageCommitment := (crewCommitment + weaponsCommitment + vehiclesCommitment) / 3.0
+	averageCommitment := (commitment(resources.Crew, operation.Resources.Crew) +
+		commitment(resources.Weapons, operation.Resources.Weapons) +
+		commitment(resources.Vehicles, operation.Resources.Vehicles)) / 3.0
 
-	// Adjust success chance based on commitment
 	if averageCommitment > 100.0 {
 		// Bonus for over-committing resources
-		successChance += int((averageCommitment - 100.0) / 10.0)
+		return &model.SuccessModifier{
+			Source: util.SuccessModifierStrength,
+			Label:  "Over-committed resources",
+			Value:  int((averageCommitment - 100.0) / 10.0),
+		}
 	} else if averageCommitment < 100.0 {
 		// Penalty for under-committing resources
-		successChance -= int((100.0 - averageCommitment) / 5.0)
-	}
-
-	// Cap success chance between 5% and 95%
-	if successChance < 5 {
-		successChance = 5
-	} else if successChance > 95 {
-		successChance = 95
+		return &model.SuccessModifier{
+			Source: util.SuccessModifierStrength,
+			Label:  "Under-committed resources",
+			Value:  -int((100.0 - averageCommitment) / 5.0),
+		}
 	}
-
-	return successChance
+	return nil
 }
 
 // getSuccessMessage returns a success message for the operation type
@@ -1441,18 +1432,25 @@ func formatDuration(seconds int) string {
 	return fmt.Sprintf("%d minutes", minutes)
 }
 
+// titleRanks orders the player titles from lowest to highest
+var titleRanks = map[string]int{
+	util.PlayerTitleAssociate:   1,
+	util.PlayerTitleSoldier:     2,
+	util.PlayerTitleCapo:        3,
+	util.PlayerTitleUnderboss:   4,
+	util.PlayerTitleConsigliere: 5,
+	util.PlayerTitleBoss:        6,
+	util.PlayerTitleGodfather:   7,
+}
+
+// isValidTitle checks whether a title is one of the known player titles
+func isValidTitle(title string) bool {
+	_, ok := titleRanks[title]
+	return ok
+}
+
 // meetsMinimumTitle checks if the player's title meets the minimum required title
 func meetsMinimumTitle(playerTitle, requiredTitle string) bool {
-	titleRanks := map[string]int{
-		util.PlayerTitleAssociate:   1,
-		util.PlayerTitleSoldier:     2,
-		util.PlayerTitleCapo:        3,
-		util.PlayerTitleUnderboss:   4,
-		util.PlayerTitleConsigliere: 5,
-		util.PlayerTitleBoss:        6,
-		util.PlayerTitleGodfather:   7,
-	}
-
 	playerRank, ok := titleRanks[playerTitle]
 	if !ok {
 		return false
@@ -1465,3 +1463,13 @@ func meetsMinimumTitle(playerTitle, requiredTitle string) bool {
 
 	return playerRank >= requiredRank
 }
+
+// neighborhoodTierName looks up the display name of a neighborhood bonus tier
+func (s *operationsService) neighborhoodTierName(tierID string) string {
+	for _, tier := range s.gameConfig.Mechanics.Neighborhood.Tiers {
+		if tier.ID == tierID {
+			return tier.Name
+		}
+	}
+	return tierID
+}
@@ -37,6 +37,7 @@ type OperationRequirementsYAML struct {
 	MaxHeat              int    `yaml:"max_heat"`
 	MinTitle             string `yaml:"min_title"`
 	RequiredHotspotTypes string `yaml:"required_hotspot_types"`
+	RequiredBonus        string `yaml:"required_bonus,omitempty"`
 }
 
 type OperationResourcesYAML struct {
@@ -72,6 +73,7 @@ func (y OperationRequirementsYAML) ToModel() model.OperationRequirements {
 		MaxHeat:              y.MaxHeat,
 		MinTitle:             y.MinTitle,
 		RequiredHotspotTypes: y.RequiredHotspotTypes,
+		RequiredBonus:        y.RequiredBonus,
 	}
 }
 
@@ -107,6 +109,129 @@ func (y OperationRisksYAML) ToModel() model.OperationRisks {
 	}
 }
 
+// Convert model structs back to YAML structs
+func operationRequirementsFromModel(m model.OperationRequirements) OperationRequirementsYAML {
+	return OperationRequirementsYAML{
+		MinInfluence:         m.MinInfluence,
+		MaxHeat:              m.MaxHeat,
+		MinTitle:             m.MinTitle,
+		RequiredHotspotTypes: m.RequiredHotspotTypes,
+		RequiredBonus:        m.RequiredBonus,
+	}
+}
+
+func operationResourcesFromModel(m model.OperationResources) OperationResourcesYAML {
+	return OperationResourcesYAML{
+		Crew:     m.Crew,
+		Weapons:  m.Weapons,
+		Vehicles: m.Vehicles,
+		Money:    m.Money,
+	}
+}
+
+func operationRewardsFromModel(m model.OperationRewards) OperationRewardsYAML {
+	return OperationRewardsYAML{
+		Money:         m.Money,
+		Crew:          m.Crew,
+		Weapons:       m.Weapons,
+		Vehicles:      m.Vehicles,
+		Respect:       m.Respect,
+		Influence:     m.Influence,
+		HeatReduction: m.HeatReduction,
+	}
+}
+
+func operationRisksFromModel(m model.OperationRisks) OperationRisksYAML {
+	return OperationRisksYAML{
+		CrewLoss:     m.CrewLoss,
+		WeaponsLoss:  m.WeaponsLoss,
+		VehiclesLoss: m.VehiclesLoss,
+		MoneyLoss:    m.MoneyLoss,
+		HeatIncrease: m.HeatIncrease,
+		RespectLoss:  m.RespectLoss,
+	}
+}
+
+// ToModel converts a YAML operation template to its admin-managed model form
+func (t OperationTemplate) ToModel() model.OperationTemplate {
+	return model.OperationTemplate{
+		ID:                   t.ID,
+		Name:                 t.Name,
+		Description:          t.Description,
+		Type:                 t.Type,
+		IsSpecial:            t.IsSpecial,
+		Regions:              t.Regions,
+		Requirements:         t.Requirements.ToModel(),
+		Resources:            t.Resources.ToModel(),
+		Rewards:              t.Rewards.ToModel(),
+		Risks:                t.Risks.ToModel(),
+		Duration:             t.Duration,
+		AvailabilityDuration: t.AvailabilityDuration,
+		SuccessRate:          t.SuccessRate,
+	}
+}
+
+// operationTemplateFromModel converts an admin-managed template to the YAML template form
+func operationTemplateFromModel(m model.OperationTemplate) OperationTemplate {
+	return OperationTemplate{
+		ID:                   m.ID,
+		Name:                 m.Name,
+		Description:          m.Description,
+		Type:                 m.Type,
+		IsSpecial:            m.IsSpecial,
+		Regions:              m.Regions,
+		AvailabilityDuration: m.AvailabilityDuration,
+		Requirements:         operationRequirementsFromModel(m.Requirements),
+		Resources:            operationResourcesFromModel(m.Resources),
+		Rewards:              operationRewardsFromModel(m.Rewards),
+		Risks:                operationRisksFromModel(m.Risks),
+		Duration:             m.Duration,
+		SuccessRate:          m.SuccessRate,
+	}
+}
+
+// applyOperationTemplates overlays admin-managed templates on top of the YAML pool.
+// Templates with a matching ID replace the YAML entry, retired ones remove it, and new ones are appended.
+func applyOperationTemplates(data *OperationsData, templates []model.OperationTemplate) {
+	overrides := make(map[string]model.OperationTemplate, len(templates))
+	for _, template := range templates {
+		overrides[template.ID] = template
+	}
+
+	var basic, special []OperationTemplate
+	for _, template := range append(data.BasicOperations, data.SpecialOperations...) {
+		if override, exists := overrides[template.ID]; exists {
+			delete(overrides, template.ID)
+			if override.IsRetired {
+				continue
+			}
+			template = operationTemplateFromModel(override)
+		}
+
+		if template.IsSpecial {
+			special = append(special, template)
+		} else {
+			basic = append(basic, template)
+		}
+	}
+
+	// Remaining templates only exist in the database
+	for _, template := range templates {
+		if _, pending := overrides[template.ID]; !pending || template.IsRetired {
+			continue
+		}
+
+		if template.IsSpecial {
+			special = append(special, operationTemplateFromModel(template))
+		} else {
+			basic = append(basic, operationTemplateFromModel(template))
+		}
+	}
+
+	data.BasicOperations = basic
+	data.SpecialOperations = special
+}
+
 // loadOperationsFromYAML reads operations data from the configured YAML file
 func loadOperationsFromYAML() (*OperationsData, error) {
 	// Get the operations YAML file path from environment or use default
@@ -0,0 +1,116 @@
+// internal/service/pity.go
+
+package service
+
+import (
+	"fmt"
+
+	"mwce-be/internal/config"
+	"mwce-be/internal/model"
+	"mwce-be/internal/repository"
+	"mwce-be/internal/util"
+
+	"github.com/rs/zerolog"
+)
+
+// pityBonus works out the success bonus a failure streak has earned in a category
+func pityBonus(mechanics *config.MechanicsConfig, category string, streak int) int {
+	if mechanics == nil {
+		return 0
+	}
+
+	curve, exists := mechanics.Pity[category]
+	if !exists || streak < curve.StartAfter {
+		return 0
+	}
+
+	bonus := (streak - curve.StartAfter + 1) * curve.BonusPerFailure
+	if bonus > curve.MaxBonus {
+		bonus = curve.MaxBonus
+	}
+	return bonus
+}
+
+// currentPityBonus looks up a player's failure streak and returns the bonus it has earned
+func currentPityBonus(playerRepo repository.PlayerRepository, mechanics *config.MechanicsConfig, playerID, category string) (int, int, error) {
+	streak, err := playerRepo.GetFailureStreak(playerID, category)
+	if err != nil {
+		return 0, 0, err
+	}
+	return pityBonus(mechanics, category, streak), streak, nil
+}
+
+// pityBonuses returns the bonus every failure streak of a player has earned, leaving out categories without one
+func pityBonuses(playerRepo repository.PlayerRepository, mechanics *config.MechanicsConfig, playerID string) (map[string]int, error) {
+	streaks, err := playerRepo.GetFailureStreaks(playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	bonuses := make(map[string]int)
+	for _, streak := range streaks {
+		if bonus := pityBonus(mechanics, streak.Category, streak.Count); bonus > 0 {
+			bonuses[streak.Category] = bonus
+		}
+	}
+	return bonuses, nil
+}
+
+// recordPityOutcome extends the player's failure streak in a category, or resets it on success
+func recordPityOutcome(playerRepo repository.PlayerRepository, logger zerolog.Logger, playerID, category string, success bool) {
+	if err := playerRepo.RecordFailureStreak(playerID, category, !success); err != nil {
+		logger.Error().Err(err).
+			Str("playerID", playerID).
+			Str("category", category).
+			Msg("Failed to record failure streak")
+	}
+}
+
+// territoryPityCategory maps a territory action to the failure streak it counts towards
+func territoryPityCategory(actionType string) (string, bool) {
+	switch actionType {
+	case util.TerritoryActionTypeExtortion:
+		return util.PityCategoryExtortion, true
+	case util.TerritoryActionTypeTakeover:
+		return util.PityCategoryTakeover, true
+	}
+	return "", false
+}
+
+// pityChanceModifier helps a player who keeps failing the same kind of territory action
+func (s *territoryService) pityChanceModifier(action *chanceAction) *model.SuccessModifier {
+	category, tracked := territoryPityCategory(action.ActionType)
+	if !tracked {
+		return nil
+	}
+
+	bonus, streak, err := currentPityBonus(s.playerRepo, s.gameConfig.Mechanics, action.Player.ID, category)
+	if err != nil {
+		s.logger.Error().Err(err).Str("playerID", action.Player.ID).Msg("Failed to get failure streak")
+		return nil
+	}
+	return pityModifier(bonus, streak)
+}
+
+// operationPityModifier helps a player who keeps failing operations
+func (s *operationsService) operationPityModifier(playerID string) *model.SuccessModifier {
+	bonus, streak, err := currentPityBonus(s.playerRepo, s.gameConfig.Mechanics, playerID, util.PityCategoryOperations)
+	if err != nil {
+		s.logger.Error().Err(err).Str("playerID", playerID).Msg("Failed to get failure streak")
+		return nil
+	}
+	return pityModifier(bonus, streak)
+}
+
+// pityModifier turns a failure streak bonus into a success modifier, or nil when there is none
+func pityModifier(bonus, streak int) *model.SuccessModifier {
+	if bonus == 0 {
+		return nil
+	}
+
+	return &model.SuccessModifier{
+		Source: util.SuccessModifierPity,
+		Label:  fmt.Sprintf("Due for a break (%d failures in a row)", streak),
+		Value:  bonus,
+	}
+}
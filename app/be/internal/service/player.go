@@ -29,17 +29,19 @@ type PlayerService interface {
 }
 
 type playerService struct {
-	playerRepo repository.PlayerRepository
-	gameConfig config.GameConfig
-	logger     zerolog.Logger
+	playerRepo          repository.PlayerRepository
+	neighborhoodService NeighborhoodService
+	gameConfig          config.GameConfig
+	logger              zerolog.Logger
 }
 
 // NewPlayerService creates a new player service
-func NewPlayerService(playerRepo repository.PlayerRepository, gameConfig config.GameConfig, logger zerolog.Logger) PlayerService {
+func NewPlayerService(playerRepo repository.PlayerRepository, neighborhoodService NeighborhoodService, gameConfig config.GameConfig, logger zerolog.Logger) PlayerService {
 	return &playerService{
-		playerRepo: playerRepo,
-		gameConfig: gameConfig,
-		logger:     logger,
+		playerRepo:          playerRepo,
+		neighborhoodService: neighborhoodService,
+		gameConfig:          gameConfig,
+		logger:              logger,
 	}
 }
 
@@ -52,6 +54,7 @@ func (s *playerService) CreateNewPlayer(name, email, password string) (*model.Pl
 		Email:       email,
 		Password:    password,                  // Should be hashed by caller
 		Title:       util.PlayerTitleAssociate, // Starting title
+		Role:        util.PlayerRolePlayer,
 		Money:       s.gameConfig.ResourceLimit.InitialMoney,
 		Crew:        s.gameConfig.ResourceLimit.InitialCrew,
 		MaxCrew:     s.gameConfig.ResourceLimit.MaxCrew,
@@ -71,7 +74,32 @@ func (s *playerService) CreateNewPlayer(name, email, password string) (*model.Pl
 
 // GetProfile retrieves a player's profile
 func (s *playerService) GetProfile(playerID string) (*model.Player, error) {
-	return s.playerRepo.GetPlayerByID(playerID)
+	player, err := s.playerRepo.GetPlayerByID(playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only show a loss shield that is still up
+	if !isProtected(player.ProtectedUntil, time.Now()) {
+		player.ProtectedUntil = nil
+	}
+
+	// Attach the success bonuses earned by failure streaks
+	if bonuses, err := pityBonuses(s.playerRepo, s.gameConfig.Mechanics, playerID); err != nil {
+		s.logger.Error().Err(err).Msg("Failed to get failure streaks for profile")
+	} else if len(bonuses) > 0 {
+		player.PityBonuses = bonuses
+	}
+
+	// Attach the player's active neighborhood bonuses
+	bonuses, err := s.neighborhoodService.GetPlayerBonuses(playerID)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("Failed to get neighborhood bonuses for profile")
+		return player, nil // Return the profile even if we can't get the bonuses
+	}
+	player.NeighborhoodBonuses = bonuses
+
+	return player, nil
 }
 
 // GetStats retrieves a player's statistics
@@ -97,6 +125,16 @@ func (s *playerService) MarkNotificationRead(notificationID, playerID string) er
 
 // CollectAllPending collects all pending resources for a player
 func (s *playerService) CollectAllPending(playerID string) (*model.CollectAllResponse, error) {
+	player, err := s.playerRepo.GetPlayerByID(playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the player is in a state to act
+	if err := checkPlayerCanAct(player); err != nil {
+		return nil, err
+	}
+
 	// Collect pending resources
 	collectedAmount, err := s.playerRepo.CollectAllPending(playerID)
 	if err != nil {
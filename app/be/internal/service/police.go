@@ -0,0 +1,297 @@
+// internal/service/police.go
+
+package service
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"mwce-be/internal/config"
+	"mwce-be/internal/model"
+	"mwce-be/internal/repository"
+	"mwce-be/internal/util"
+
+	"github.com/rs/zerolog"
+)
+
+// PoliceService handles police raids on the businesses of players with a lot of heat
+type PoliceService interface {
+	ProcessRaids() error
+
+	// Scheduled jobs
+	StartPeriodicRaids()
+}
+
+type policeService struct {
+	playerRepo       repository.PlayerRepository
+	territoryRepo    repository.TerritoryRepository
+	operationsRepo   repository.OperationsRepository
+	territoryService TerritoryService
+	sseService       SSEService
+	gameConfig       config.GameConfig
+	logger           zerolog.Logger
+	rng              *rand.Rand       // Only used from the raid scheduler's goroutine
+	now              func() time.Time // Clock used for raid timing
+}
+
+// NewPoliceService creates a new police service.
+// Raids roll on their own random source, seeded from the config so a run can be reproduced.
+func NewPoliceService(
+	playerRepo repository.PlayerRepository,
+	territoryRepo repository.TerritoryRepository,
+	operationsRepo repository.OperationsRepository,
+	territoryService TerritoryService,
+	sseService SSEService,
+	gameConfig config.GameConfig,
+	logger zerolog.Logger,
+) PoliceService {
+	seed := gameConfig.Mechanics.PoliceRaids.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+
+	return newPoliceService(playerRepo, territoryRepo, operationsRepo, territoryService, sseService, gameConfig, logger,
+		rand.New(rand.NewSource(seed)), time.Now)
+}
+
+// newPoliceService creates a police service with the given random source and clock
+func newPoliceService(
+	playerRepo repository.PlayerRepository,
+	territoryRepo repository.TerritoryRepository,
+	operationsRepo repository.OperationsRepository,
+	territoryService TerritoryService,
+	sseService SSEService,
+	gameConfig config.GameConfig,
+	logger zerolog.Logger,
+	rng *rand.Rand,
+	now func() time.Time,
+) *policeService {
+	return &policeService{
+		playerRepo:       playerRepo,
+		territoryRepo:    territoryRepo,
+		operationsRepo:   operationsRepo,
+		territoryService: territoryService,
+		sseService:       sseService,
+		gameConfig:       gameConfig,
+		logger:           logger,
+		rng:              rng,
+		now:              now,
+	}
+}
+
+// ProcessRaids rolls a raid for every player hot enough to draw the police.
+// Players come hottest first, so a given seed always raids the same targets.
+func (s *policeService) ProcessRaids() error {
+	tuning := s.gameConfig.Mechanics.PoliceRaids
+
+	players, err := s.playerRepo.GetPlayersWithHeatAtLeast(tuning.MinHeat)
+	if err != nil {
+		return err
+	}
+
+	now := s.now()
+	for i := range players {
+		player := &players[i]
+
+		chance := s.raidChance(player, now)
+		if s.rng.Float64()*100 >= chance {
+			continue
+		}
+
+		if err := s.raidPlayer(player, now); err != nil {
+			s.logger.Error().Err(err).Str("playerID", player.ID).Msg("Failed to raid player")
+		}
+	}
+
+	return nil
+}
+
+// raidChance works out the odds of a player being raided this pass from their heat and recent bribes
+func (s *policeService) raidChance(player *model.Player, now time.Time) float64 {
+	tuning := s.gameConfig.Mechanics.PoliceRaids
+
+	chance := tuning.BaseChance + float64(player.Heat-tuning.MinHeat)*tuning.ChancePerHeat
+	if chance > tuning.MaxChance {
+		chance = tuning.MaxChance
+	}
+
+	// Officials paid off recently look the other way
+	if tuning.Bribes.CoverDuration > 0 && tuning.Bribes.ChanceReduction > 0 {
+		since := now.Add(-time.Duration(tuning.Bribes.CoverDuration) * time.Second)
+		bribes, err := s.operationsRepo.CountSuccessfulOperationsSince(player.ID, util.OperationTypeOfficialBribing, since)
+		if err != nil {
+			s.logger.Error().Err(err).Str("playerID", player.ID).Msg("Failed to count recent bribes")
+		}
+
+		reduction := int(bribes) * tuning.Bribes.ChanceReduction
+		if reduction > tuning.Bribes.MaxReduction {
+			reduction = tuning.Bribes.MaxReduction
+		}
+		chance = chance * float64(100-reduction) / 100
+	}
+
+	return chance
+}
+
+// raidPlayer picks one of the player's businesses, weighted by income, and raids it
+func (s *policeService) raidPlayer(player *model.Player, now time.Time) error {
+	hotspots, err := s.territoryRepo.GetControlledHotspots(player.ID)
+	if err != nil {
+		return err
+	}
+
+	target := s.pickRaidTarget(hotspots, now)
+	if target == nil {
+		return nil
+	}
+
+	return s.raidHotspot(player, target, now)
+}
+
+// pickRaidTarget picks a business to raid, weighted by income, skipping the ones already shut down
+func (s *policeService) pickRaidTarget(hotspots []model.Hotspot, now time.Time) *model.Hotspot {
+	candidates := make([]*model.Hotspot, 0, len(hotspots))
+	weights := make([]int, 0, len(hotspots))
+	totalWeight := 0
+	for i := range hotspots {
+		hotspot := &hotspots[i]
+		if hotspotClosed(hotspot, now) {
+			continue
+		}
+
+		// Every business can be raided, the lucrative ones draw the most attention
+		weight := hotspot.Income + 1
+		candidates = append(candidates, hotspot)
+		weights = append(weights, weight)
+		totalWeight += weight
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	pick := s.rng.Intn(totalWeight)
+	for i, weight := range weights {
+		if pick < weight {
+			return candidates[i]
+		}
+		pick -= weight
+	}
+	return candidates[len(candidates)-1]
+}
+
+// rollRaid works out what a raid takes from a business whose income is up to date
+func (s *policeService) rollRaid(hotspot *model.Hotspot, now time.Time) *model.PoliceRaid {
+	tuning := s.gameConfig.Mechanics.PoliceRaids
+
+	raid := &model.PoliceRaid{
+		HotspotID:    hotspot.ID,
+		HotspotName:  hotspot.Name,
+		MoneySeized:  hotspot.PendingCollection * s.rollPercent(tuning.SeizePercent) / 100,
+		CrewLost:     hotspot.Crew * s.rollPercent(tuning.GarrisonLoss) / 100,
+		WeaponsLost:  hotspot.Weapons * s.rollPercent(tuning.GarrisonLoss) / 100,
+		VehiclesLost: hotspot.Vehicles * s.rollPercent(tuning.GarrisonLoss) / 100,
+	}
+	if hotspot.ControllerID != nil {
+		raid.ControllerID = *hotspot.ControllerID
+	}
+
+	if s.rng.Intn(100) < tuning.CloseChance && tuning.CloseDuration > 0 {
+		until := now.Add(time.Duration(tuning.CloseDuration) * time.Second)
+		raid.ClosedUntil = &until
+	}
+
+	return raid
+}
+
+// raidHotspot seizes part of a business's takings, destroys part of its garrison and may shut it down
+func (s *policeService) raidHotspot(player *model.Player, hotspot *model.Hotspot, now time.Time) error {
+	// Income earned so far is on the premises when the police arrive
+	incomeTimeBefore := hotspot.LastIncomeTime
+	accrued := accrueIncome(hotspot, now)
+
+	raid := s.rollRaid(hotspot, now)
+
+	// A closed business earns nothing until it reopens
+	incomeTimeAfter := hotspot.LastIncomeTime
+	if raid.ClosedUntil != nil && incomeTimeAfter != nil {
+		incomeTimeAfter = raid.ClosedUntil
+	}
+
+	applied, err := s.territoryRepo.ApplyPoliceRaid(raid, accrued, incomeTimeBefore, incomeTimeAfter)
+	if err != nil {
+		return err
+	}
+	if !applied {
+		// The business changed hands or paid out while the police were on their way
+		return nil
+	}
+
+	if err := s.territoryService.RefreshDefenseStrength(hotspot.ID); err != nil {
+		s.logger.Error().Err(err).Str("hotspotID", hotspot.ID).Msg("Failed to refresh defense after police raid")
+	}
+
+	message := fmt.Sprintf("Police raided %s! They seized $%s", raid.HotspotName, formatMoney(raid.MoneySeized))
+	if raid.CrewLost+raid.WeaponsLost+raid.VehiclesLost > 0 {
+		message += fmt.Sprintf(" and took out %d crew, %d weapons and %d vehicles", raid.CrewLost, raid.WeaponsLost, raid.VehiclesLost)
+	}
+	message += "."
+	if raid.ClosedUntil != nil {
+		message += fmt.Sprintf(" The business is shut down until %s.", raid.ClosedUntil.Format(time.Kitchen))
+	}
+
+	notification := &model.Notification{
+		PlayerID:  player.ID,
+		Message:   message,
+		Type:      util.NotificationTypeHeat,
+		Timestamp: now,
+		Read:      false,
+	}
+	if err := s.playerRepo.AddNotification(notification); err != nil {
+		s.logger.Error().Err(err).Str("playerID", player.ID).Msg("Failed to add police raid notification")
+	}
+
+	s.sseService.SendEventToPlayer(player.ID, "police_raid", map[string]interface{}{
+		"hotspotId":    raid.HotspotID,
+		"hotspotName":  raid.HotspotName,
+		"moneySeized":  raid.MoneySeized,
+		"crewLost":     raid.CrewLost,
+		"weaponsLost":  raid.WeaponsLost,
+		"vehiclesLost": raid.VehiclesLost,
+		"closedUntil":  raid.ClosedUntil,
+		"message":      message,
+		"timestamp":    now.Format(time.RFC3339),
+	})
+
+	s.logger.Info().
+		Str("playerID", player.ID).
+		Str("hotspotID", hotspot.ID).
+		Int("heat", player.Heat).
+		Int("moneySeized", raid.MoneySeized).
+		Bool("closed", raid.ClosedUntil != nil).
+		Msg("Police raided hotspot")
+
+	return nil
+}
+
+// rollPercent picks a percentage in an inclusive range from the raid random source
+func (s *policeService) rollPercent(r config.IntRange) int {
+	if r.Max <= r.Min {
+		return r.Min
+	}
+	return r.Min + s.rng.Intn(r.Max-r.Min+1)
+}
+
+// checkHotspotOpen returns an error when a police raid has shut the business down.
+// Every way of collecting a hotspot's income goes through it.
+func checkHotspotOpen(hotspot *model.Hotspot, now time.Time) error {
+	if hotspotClosed(hotspot, now) {
+		return fmt.Errorf("%s is shut down by the police until %s", hotspot.Name, hotspot.ClosedUntil.Format(time.Kitchen))
+	}
+	return nil
+}
+
+// hotspotClosed reports whether a police raid has shut the business down
+func hotspotClosed(hotspot *model.Hotspot, now time.Time) bool {
+	return hotspot.ClosedUntil != nil && hotspot.ClosedUntil.After(now)
+}
@@ -0,0 +1,20 @@
+// internal/service/police_scheduler.go
+
+package service
+
+import "time"
+
+// StartPeriodicRaids starts a goroutine that periodically sends the police after players with a lot of heat
+func (s *policeService) StartPeriodicRaids() {
+	ticker := time.NewTicker(time.Duration(s.gameConfig.Mechanics.PoliceRaids.Interval) * time.Second)
+
+	go func() {
+		for range ticker.C {
+			if err := s.ProcessRaids(); err != nil {
+				s.logger.Error().Err(err).Msg("Failed to process police raids")
+			}
+		}
+	}()
+
+	s.logger.Info().Msg("Started police raid scheduler")
+}
@@ -0,0 +1,214 @@
+// internal/service/police_test.go
+
+package service
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"mwce-be/internal/config"
+	"mwce-be/internal/model"
+	"mwce-be/internal/repository"
+
+	"github.com/rs/zerolog"
+)
+
+type fakeRaidPlayerRepo struct {
+	repository.PlayerRepository
+	players       []model.Player
+	notifications []model.Notification
+}
+
+func (r *fakeRaidPlayerRepo) GetPlayersWithHeatAtLeast(minHeat int) ([]model.Player, error) {
+	return r.players, nil
+}
+
+func (r *fakeRaidPlayerRepo) AddNotification(notification *model.Notification) error {
+	r.notifications = append(r.notifications, *notification)
+	return nil
+}
+
+type fakeRaidTerritoryRepo struct {
+	repository.TerritoryRepository
+	hotspots []model.Hotspot
+	raids    []model.PoliceRaid
+}
+
+func (r *fakeRaidTerritoryRepo) GetControlledHotspots(playerID string) ([]model.Hotspot, error) {
+	// Hand out copies, like reading fresh rows
+	hotspots := make([]model.Hotspot, len(r.hotspots))
+	copy(hotspots, r.hotspots)
+	return hotspots, nil
+}
+
+func (r *fakeRaidTerritoryRepo) ApplyPoliceRaid(raid *model.PoliceRaid, accrued int, incomeTimeBefore, incomeTimeAfter *time.Time) (bool, error) {
+	r.raids = append(r.raids, *raid)
+	return true, nil
+}
+
+type fakeRaidOperationsRepo struct {
+	repository.OperationsRepository
+	bribes int64
+}
+
+func (r *fakeRaidOperationsRepo) CountSuccessfulOperationsSince(playerID, operationType string, since time.Time) (int64, error) {
+	return r.bribes, nil
+}
+
+type fakeRaidTerritoryService struct {
+	TerritoryService
+}
+
+func (s *fakeRaidTerritoryService) RefreshDefenseStrength(hotspotID string) error {
+	return nil
+}
+
+type fakeRaidSSEService struct {
+	SSEService
+	events []string
+}
+
+func (s *fakeRaidSSEService) SendEventToPlayer(playerID string, eventType string, data interface{}) {
+	s.events = append(s.events, eventType)
+}
+
+var raidTestNow = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func raidTestConfig() config.GameConfig {
+	return config.GameConfig{
+		Mechanics: &config.MechanicsConfig{
+			PoliceRaids: config.PoliceRaidConfig{
+				Interval:      900,
+				MinHeat:       60,
+				BaseChance:    100,
+				MaxChance:     100,
+				SeizePercent:  config.IntRange{Min: 50, Max: 50},
+				GarrisonLoss:  config.IntRange{Min: 25, Max: 25},
+				CloseChance:   0,
+				CloseDuration: 3600,
+				Bribes: config.RaidBribes{
+					CoverDuration:   7200,
+					ChanceReduction: 35,
+					MaxReduction:    80,
+				},
+			},
+		},
+	}
+}
+
+func newTestPoliceService(seed int64, gameConfig config.GameConfig, hotspots []model.Hotspot, bribes int64) (*policeService, *fakeRaidTerritoryRepo, *fakeRaidSSEService) {
+	controllerID := "player-1"
+	for i := range hotspots {
+		hotspots[i].ControllerID = &controllerID
+	}
+
+	playerRepo := &fakeRaidPlayerRepo{players: []model.Player{{ID: controllerID, Heat: 90}}}
+	territoryRepo := &fakeRaidTerritoryRepo{hotspots: hotspots}
+	sseService := &fakeRaidSSEService{}
+
+	s := newPoliceService(playerRepo, territoryRepo, &fakeRaidOperationsRepo{bribes: bribes}, &fakeRaidTerritoryService{},
+		sseService, gameConfig, zerolog.Nop(), rand.New(rand.NewSource(seed)), func() time.Time { return raidTestNow })
+	return s, territoryRepo, sseService
+}
+
+func raidTestHotspots() []model.Hotspot {
+	closed := raidTestNow.Add(time.Hour)
+	return []model.Hotspot{
+		{ID: "bar", Name: "Bar", Income: 0, PendingCollection: 1000, Crew: 8, Weapons: 4, Vehicles: 2},
+		{ID: "casino", Name: "Casino", Income: 9000, PendingCollection: 1000, Crew: 8, Weapons: 4, Vehicles: 2},
+		{ID: "club", Name: "Club", Income: 50000, ClosedUntil: &closed},
+	}
+}
+
+func TestPoliceRaidsAreReproducibleWithSeed(t *testing.T) {
+	run := func() []string {
+		s, territoryRepo, _ := newTestPoliceService(42, raidTestConfig(), raidTestHotspots(), 0)
+		for i := 0; i < 50; i++ {
+			if err := s.ProcessRaids(); err != nil {
+				t.Fatalf("ProcessRaids: %v", err)
+			}
+		}
+
+		targets := make([]string, len(territoryRepo.raids))
+		for i, raid := range territoryRepo.raids {
+			targets[i] = raid.HotspotID
+		}
+		return targets
+	}
+
+	first, second := run(), run()
+	if len(first) != 50 {
+		t.Fatalf("expected a raid every pass, got %d", len(first))
+	}
+	for i := range first {
+		if first[i] != second[i] {
+			t.Fatalf("raid %d hit %s then %s with the same seed", i, first[i], second[i])
+		}
+	}
+
+	// Income weights the pick, and closed businesses are left alone
+	counts := make(map[string]int)
+	for _, target := range first {
+		counts[target]++
+	}
+	if counts["club"] != 0 {
+		t.Errorf("closed business was raided %d times", counts["club"])
+	}
+	if counts["casino"] <= counts["bar"] {
+		t.Errorf("expected the lucrative casino to be raided most, got casino %d bar %d", counts["casino"], counts["bar"])
+	}
+}
+
+func TestPoliceRaidSeizure(t *testing.T) {
+	gameConfig := raidTestConfig()
+	gameConfig.Mechanics.PoliceRaids.CloseChance = 100
+
+	s, territoryRepo, sseService := newTestPoliceService(7, gameConfig, raidTestHotspots()[:1], 0)
+	if err := s.ProcessRaids(); err != nil {
+		t.Fatalf("ProcessRaids: %v", err)
+	}
+
+	if len(territoryRepo.raids) != 1 {
+		t.Fatalf("expected one raid, got %d", len(territoryRepo.raids))
+	}
+	raid := territoryRepo.raids[0]
+	if raid.MoneySeized != 500 {
+		t.Errorf("expected $500 seized, got %d", raid.MoneySeized)
+	}
+	if raid.CrewLost != 2 || raid.WeaponsLost != 1 || raid.VehiclesLost != 0 {
+		t.Errorf("unexpected garrison losses: crew %d weapons %d vehicles %d", raid.CrewLost, raid.WeaponsLost, raid.VehiclesLost)
+	}
+	if raid.ClosedUntil == nil || !raid.ClosedUntil.Equal(raidTestNow.Add(time.Hour)) {
+		t.Errorf("expected the business closed for an hour, got %v", raid.ClosedUntil)
+	}
+	if raid.ControllerID != "player-1" {
+		t.Errorf("expected the raid guarded on the controller, got %q", raid.ControllerID)
+	}
+	if len(sseService.events) != 1 || sseService.events[0] != "police_raid" {
+		t.Errorf("expected a police_raid event, got %v", sseService.events)
+	}
+}
+
+func TestPoliceRaidChanceBribeReduction(t *testing.T) {
+	gameConfig := raidTestConfig()
+	gameConfig.Mechanics.PoliceRaids.BaseChance = 10
+	gameConfig.Mechanics.PoliceRaids.ChancePerHeat = 1
+	gameConfig.Mechanics.PoliceRaids.MaxChance = 40
+	player := &model.Player{ID: "player-1", Heat: 80}
+
+	tests := []struct {
+		bribes int64
+		want   float64
+	}{
+		{bribes: 0, want: 30},
+		{bribes: 1, want: 19.5},
+		{bribes: 5, want: 6}, // Capped at the maximum reduction
+	}
+	for _, test := range tests {
+		s, _, _ := newTestPoliceService(1, gameConfig, nil, test.bribes)
+		if got := s.raidChance(player, raidTestNow); got < test.want-0.001 || got > test.want+0.001 {
+			t.Errorf("%d bribes: expected %.1f%% raid chance, got %.3f%%", test.bribes, test.want, got)
+		}
+	}
+}
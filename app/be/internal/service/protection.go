@@ -0,0 +1,120 @@
+// internal/service/protection.go
+
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"mwce-be/internal/model"
+	"mwce-be/internal/util"
+)
+
+// isProtected reports whether a loss shield is still up
+func isProtected(protectedUntil *time.Time, now time.Time) bool {
+	return protectedUntil != nil && protectedUntil.After(now)
+}
+
+// checkHotspotLosses shields a player who lost a large share of their hotspots within the loss window
+func (s *territoryService) checkHotspotLosses(playerID string) {
+	tuning := s.gameConfig.Mechanics.Protection
+	if tuning.Duration <= 0 || tuning.HotspotLossPercent <= 0 {
+		return
+	}
+
+	since := time.Now().Add(-time.Duration(tuning.LossWindow) * time.Second)
+	lost, err := s.territoryRepo.CountHotspotsLostSince(playerID, since)
+	if err != nil {
+		s.logger.Error().Err(err).Str("playerID", playerID).Msg("Failed to count lost hotspots")
+		return
+	}
+	if lost == 0 || lost < tuning.MinHotspotsLost {
+		return
+	}
+
+	held, err := s.playerRepo.GetControlledHotspotsCount(playerID)
+	if err != nil {
+		s.logger.Error().Err(err).Str("playerID", playerID).Msg("Failed to count controlled hotspots")
+		return
+	}
+
+	// Holdings at the start of the window are what is left plus what was lost since
+	if lost*100/(lost+held) < tuning.HotspotLossPercent {
+		return
+	}
+
+	s.grantProtection(playerID, fmt.Sprintf("You lost %d of your businesses in a short time", lost))
+}
+
+// checkResourceWipe shields a player whose failed takeover cost them a large share of their forces
+func (s *territoryService) checkResourceWipe(player *model.Player, resourceUpdates map[string]int) {
+	tuning := s.gameConfig.Mechanics.Protection
+	if tuning.Duration <= 0 || tuning.ResourceWipePercent <= 0 {
+		return
+	}
+
+	before := player.Crew + player.Weapons + player.Vehicles
+	lost := -(resourceUpdates["crew"] + resourceUpdates["weapons"] + resourceUpdates["vehicles"])
+	if before <= 0 || lost <= 0 || lost < tuning.MinResourcesLost {
+		return
+	}
+	if lost*100/before < tuning.ResourceWipePercent {
+		return
+	}
+
+	s.grantProtection(player.ID, "Your crew was wiped out in a failed takeover")
+}
+
+// grantProtection puts up a loss shield, extending a shorter one that is already running
+func (s *territoryService) grantProtection(playerID, reason string) {
+	player, err := s.playerRepo.GetPlayerByID(playerID)
+	if err != nil {
+		s.logger.Error().Err(err).Str("playerID", playerID).Msg("Failed to get player for protection")
+		return
+	}
+
+	protectedUntil := time.Now().Add(time.Duration(s.gameConfig.Mechanics.Protection.Duration) * time.Second)
+	if player.ProtectedUntil != nil && !protectedUntil.After(*player.ProtectedUntil) {
+		return
+	}
+
+	if err := s.playerRepo.UpdatePlayerProtection(playerID, &protectedUntil); err != nil {
+		s.logger.Error().Err(err).Str("playerID", playerID).Msg("Failed to grant protection")
+		return
+	}
+
+	message := fmt.Sprintf("%s. Your businesses are protected from takeovers until %s, unless you attack first.",
+		reason, protectedUntil.Format(time.Kitchen))
+	if err := s.addNotification(playerID, message, util.NotificationTypeTerritory); err != nil {
+		s.logger.Error().Err(err).Msg("Failed to add protection notification")
+	}
+
+	s.sseService.SendEventToPlayer(playerID, "protection_granted", map[string]interface{}{
+		"protectedUntil": protectedUntil,
+		"message":        message,
+		"timestamp":      time.Now().Format(time.RFC3339),
+	})
+}
+
+// endProtection drops a player's shield early because they went on the attack
+func (s *territoryService) endProtection(player *model.Player) {
+	if !isProtected(player.ProtectedUntil, time.Now()) {
+		return
+	}
+
+	if err := s.playerRepo.UpdatePlayerProtection(player.ID, nil); err != nil {
+		s.logger.Error().Err(err).Str("playerID", player.ID).Msg("Failed to end protection")
+		return
+	}
+	player.ProtectedUntil = nil
+
+	message := "You attacked another family, so your businesses are no longer protected."
+	if err := s.addNotification(player.ID, message, util.NotificationTypeTerritory); err != nil {
+		s.logger.Error().Err(err).Msg("Failed to add protection notification")
+	}
+
+	s.sseService.SendEventToPlayer(player.ID, "protection_ended", map[string]interface{}{
+		"message":   message,
+		"timestamp": time.Now().Format(time.RFC3339),
+	})
+}
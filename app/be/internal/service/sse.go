@@ -19,11 +19,13 @@ type SSEService interface {
 	SendEventToPlayer(playerID string, eventType string, data interface{})
 	SendEventToAll(eventType string, data interface{})
 	GetConnectedPlayerIDs() []string
+	DisconnectPlayer(playerID string, reason string)
 	Close()
 }
 
 type sseService struct {
 	clients      map[string]map[string]http.ResponseWriter
+	disconnects  map[string]chan struct{} // keyed by client ID, closed to drop a connection server-side
 	clientsMutex sync.RWMutex
 	logger       zerolog.Logger
 }
@@ -32,6 +34,7 @@ type sseService struct {
 func NewSSEService(logger zerolog.Logger) SSEService {
 	return &sseService{
 		clients:      make(map[string]map[string]http.ResponseWriter),
+		disconnects:  make(map[string]chan struct{}),
 		clientsMutex: sync.RWMutex{},
 		logger:       logger,
 	}
@@ -68,11 +71,13 @@ func (s *sseService) HandleConnection(w http.ResponseWriter, r *http.Request) {
 	clientID := uuid.New().String()
 
 	// Register the client connection
+	disconnect := make(chan struct{})
 	s.clientsMutex.Lock()
 	if _, exists := s.clients[playerID]; !exists {
 		s.clients[playerID] = make(map[string]http.ResponseWriter)
 	}
 	s.clients[playerID][clientID] = w
+	s.disconnects[clientID] = disconnect
 	s.clientsMutex.Unlock()
 
 	s.logger.Info().Str("playerID", playerID).Str("clientID", clientID).Msg("New SSE connection established")
@@ -106,12 +111,7 @@ func (s *sseService) HandleConnection(w http.ResponseWriter, r *http.Request) {
 					s.logger.Error().Err(err).Str("playerID", playerID).Str("clientID", clientID).Msg("Failed to send heartbeat, closing connection")
 
 					// Remove the client
-					s.clientsMutex.Lock()
-					delete(s.clients[playerID], clientID)
-					if len(s.clients[playerID]) == 0 {
-						delete(s.clients, playerID)
-					}
-					s.clientsMutex.Unlock()
+					s.removeClient(playerID, clientID)
 
 					return
 				}
@@ -119,19 +119,51 @@ func (s *sseService) HandleConnection(w http.ResponseWriter, r *http.Request) {
 		}
 	}()
 
-	// Keep the connection alive until the client disconnects
-	notify := r.Context().Done()
-	<-notify
+	// Keep the connection alive until the client disconnects or is dropped by the server
+	select {
+	case <-r.Context().Done():
+	case <-disconnect:
+	}
 
 	// Remove the client when connection is closed
+	s.removeClient(playerID, clientID)
+
+	s.logger.Info().Str("playerID", playerID).Str("clientID", clientID).Msg("SSE connection closed")
+}
+
+// removeClient unregisters a single client connection
+func (s *sseService) removeClient(playerID, clientID string) {
 	s.clientsMutex.Lock()
+	defer s.clientsMutex.Unlock()
+
 	delete(s.clients[playerID], clientID)
 	if len(s.clients[playerID]) == 0 {
 		delete(s.clients, playerID)
 	}
+	delete(s.disconnects, clientID)
+}
+
+// DisconnectPlayer notifies and closes every open connection of a player
+func (s *sseService) DisconnectPlayer(playerID string, reason string) {
+	s.clientsMutex.Lock()
+	clients := s.clients[playerID]
+	delete(s.clients, playerID)
+
+	for clientID, client := range clients {
+		s.sendEvent(client, "disconnected", map[string]string{
+			"message": reason,
+		})
+
+		if disconnect, exists := s.disconnects[clientID]; exists {
+			close(disconnect)
+			delete(s.disconnects, clientID)
+		}
+	}
 	s.clientsMutex.Unlock()
 
-	s.logger.Info().Str("playerID", playerID).Str("clientID", clientID).Msg("SSE connection closed")
+	if len(clients) > 0 {
+		s.logger.Info().Str("playerID", playerID).Int("connections", len(clients)).Msg("Disconnected player from SSE")
+	}
 }
 
 // Update the sendEvent method to handle errors
@@ -190,6 +222,10 @@ func (s *sseService) SendEventToAll(eventType string, data interface{}) {
 // Close closes all client connections
 func (s *sseService) Close() {
 	s.clientsMutex.Lock()
+	for _, disconnect := range s.disconnects {
+		close(disconnect)
+	}
 	s.clients = make(map[string]map[string]http.ResponseWriter)
+	s.disconnects = make(map[string]chan struct{})
 	s.clientsMutex.Unlock()
 }
@@ -33,6 +33,7 @@ type TerritoryService interface {
 	GetHotspotsByCity(cityID string) ([]model.Hotspot, error)
 	GetHotspotsByCityWithInjected(playerID, cityID string) ([]model.Hotspot, error)
 	GetHotspotByID(id string) (*model.Hotspot, error)
+	GetHotspotHistory(playerID, hotspotID string) (*model.HotspotHistoryResponse, error)
 
 	GetControlledHotspots(playerID string) ([]model.Hotspot, error)
 	GetControlledHotspotsInCurrentRegion(playerID string) ([]model.Hotspot, error)
@@ -40,13 +41,42 @@ type TerritoryService interface {
 	GetRecentActionsInCurrentRegion(playerID string, limit int) ([]model.TerritoryAction, error)
 
 	PerformAction(playerID, actionType string, request model.PerformActionRequest) (*model.ActionResult, error)
-	UpdateHotspotIncome() error
+	RefreshPlayerIncome(playerID string) error
+	NotifyAccruedIncome(since, now time.Time) error
 	CollectHotspotIncome(playerID, hotspotID string) (*model.CollectResponse, error)
 	CollectAllHotspotIncome(playerID string) (*model.CollectAllResponse, error)
 	CollectAllHotspotIncomeInCurrentRegion(playerID string) (*model.CollectAllResponse, error)
 
+	RotateIllegalBusinesses() (*model.IllegalRotationResult, error)
+
+	// Garrison management
+	RebalanceGarrisons(playerID string, request model.RebalanceGarrisonsRequest) (*model.RebalanceGarrisonsResponse, error)
+	GetGarrisonTransfers(playerID string) ([]model.GarrisonTransfer, error)
+	ProcessGarrisonTransfers() error
+
+	// Fortifications
+	GetHotspotFortifications(playerID, hotspotID string) (*model.HotspotFortificationsResponse, error)
+	PurchaseUpgrade(playerID, hotspotID, trackID string) (*model.PurchaseUpgradeResponse, error)
+	ProcessFortificationUpgrades() error
+
+	// Staged assaults
+	GetAssaults(playerID string) (*model.AssaultsResponse, error)
+	ProcessAssaults() error
+
+	// Local heat
+	ProcessLocalHeatDecay() error
+
+	// Defense
+	SaveHotspot(hotspot *model.Hotspot) error
+	RefreshDefenseStrength(hotspotID string) error
+
 	// Scheduled jobs
-	StartPeriodicIncomeGeneration()
+	StartPeriodicIncomeNotifications()
+	StartPeriodicIllegalBusinessRotation()
+	StartPeriodicGarrisonTransfers()
+	StartPeriodicFortificationUpgrades()
+	StartPeriodicAssaultResolution()
+	StartPeriodicLocalHeatDecay()
 
 	// TEMP!!!
 	GetSSEService() SSEService
@@ -57,29 +87,56 @@ type TerritoryService interface {
 type territoryService struct {
 	territoryRepo          repository.TerritoryRepository
 	playerRepo             repository.PlayerRepository
+	neighborhoodService    NeighborhoodService
 	sseService             SSEService
 	gameConfig             config.GameConfig
 	logger                 zerolog.Logger
 	customHotspotProviders []CustomHotspotProvider
+	chanceModifiers        []territoryChanceModifier
+}
+
+// chanceAction describes the territory action being rolled for, so each modifier works from the same lookups
+type chanceAction struct {
+	Player     *model.Player
+	Hotspot    *model.Hotspot
+	City       *model.City // Nil when the hotspot's city could not be found
+	ActionType string
+	Matchup    *model.UnderdogMatchup // Only set for takeovers of another player's hotspot
 }
 
+// territoryChanceModifier contributes an adjustment to a territory action's success chance, or nil when it does not apply
+type territoryChanceModifier func(action *chanceAction) *model.SuccessModifier
+
 // NewTerritoryService creates a new territory service
 func NewTerritoryService(
 	territoryRepo repository.TerritoryRepository,
 	playerRepo repository.PlayerRepository,
+	neighborhoodService NeighborhoodService,
 	sseService SSEService,
 	gameConfig config.GameConfig,
 	logger zerolog.Logger,
 	customHotspotProviders []CustomHotspotProvider,
 ) TerritoryService {
-	return &territoryService{
+	s := &territoryService{
 		territoryRepo:          territoryRepo,
 		playerRepo:             playerRepo,
+		neighborhoodService:    neighborhoodService,
 		sseService:             sseService,
 		gameConfig:             gameConfig,
 		logger:                 logger,
 		customHotspotProviders: customHotspotProviders,
 	}
+
+	// Modifiers applied to every territory action success chance, in order
+	s.chanceModifiers = []territoryChanceModifier{
+		s.heatChanceModifier,
+		s.fortificationChanceModifier,
+		s.underdogChanceModifier,
+		s.pityChanceModifier,
+		s.localHeatChanceModifier,
+	}
+
+	return s
 }
 
 // AddHotspotProvider adds a provider for injected hotspots
@@ -96,6 +153,7 @@ func (s *territoryService) GetHotspotsByCityWithInjected(playerID, cityID string
 	if err != nil {
 		return nil, err
 	}
+	accrueHotspotsIncome(hotspots)
 
 	// Get player to find their current region
 	player, err := s.playerRepo.GetPlayerByID(playerID)
@@ -143,6 +201,7 @@ func (s *territoryService) GetHotspotsInCurrentRegion(playerID string) ([]model.
 	if err != nil {
 		return nil, err
 	}
+	accrueHotspotsIncome(hotspots)
 
 	// Get injected hotspots from providers
 	for _, provider := range s.customHotspotProviders {
@@ -171,7 +230,12 @@ func (s *territoryService) GetControlledHotspotsInCurrentRegion(playerID string)
 	}
 
 	// Get controlled hotspots in the player's current region
-	return s.territoryRepo.GetControlledHotspotsByRegion(playerID, *player.CurrentRegionID)
+	hotspots, err := s.territoryRepo.GetControlledHotspotsByRegion(playerID, *player.CurrentRegionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return accrueHotspotsIncome(hotspots), nil
 }
 
 // GetRecentActionsInCurrentRegion retrieves recent territory actions in the player's current region
@@ -199,6 +263,11 @@ func (s *territoryService) CollectAllHotspotIncomeInCurrentRegion(playerID strin
 		return nil, err
 	}
 
+	// Check the player is in a state to act
+	if err := checkPlayerCanAct(player); err != nil {
+		return nil, err
+	}
+
 	// If player is not in any region, return empty response
 	if player.CurrentRegionID == nil {
 		return &model.CollectAllResponse{
@@ -217,8 +286,13 @@ func (s *territoryService) CollectAllHotspotIncomeInCurrentRegion(playerID strin
 	totalCollected := 0
 	collectedHotspots := 0
 
-	// Collect from each hotspot
-	for _, hotspot := range hotspots {
+	// Collect from each hotspot, materializing the income earned since the last payout
+	for _, hotspot := range accrueHotspotsIncome(hotspots) {
+		// Businesses the police have shut down keep their income until they reopen
+		if checkHotspotOpen(&hotspot, time.Now()) != nil {
+			continue
+		}
+
 		if hotspot.PendingCollection > 0 {
 			// Reset pending collection
 			collectedAmount := hotspot.PendingCollection
@@ -232,7 +306,7 @@ func (s *territoryService) CollectAllHotspotIncomeInCurrentRegion(playerID strin
 			}()
 
 			// Update the hotspot
-			if err := s.territoryRepo.UpdateHotspot(&hotspot); err != nil {
+			if err := s.SaveHotspot(&hotspot); err != nil {
 				s.logger.Error().Err(err).
 					Str("hotspotID", hotspot.ID).
 					Msg("Failed to update hotspot after collection")
@@ -292,101 +366,6 @@ func (s *territoryService) CollectAllHotspotIncomeInCurrentRegion(playerID strin
 	}, nil
 }
 
-// UpdateHotspotIncome now filters by region for better performance
-func (s *territoryService) UpdateHotspotIncome() error {
-	// Get all regions to update hotspots by region
-	regions, err := s.territoryRepo.GetAllRegions()
-	if err != nil {
-		return err
-	}
-
-	currentTime := time.Now()
-
-	// Process hotspots by region for better organization and performance
-	for _, region := range regions {
-		// Get all legal hotspots with controllers in this region
-		hotspots, err := s.territoryRepo.GetAllControlledLegalHotspotsByRegion(region.ID)
-		if err != nil {
-			s.logger.Error().Err(err).Str("regionID", region.ID).Msg("Failed to get controlled hotspots for region")
-			continue
-		}
-
-		// Process each hotspot in the region
-		for _, hotspot := range hotspots {
-			if hotspot.ControllerID == nil {
-				continue
-			}
-
-			playerID := *hotspot.ControllerID
-
-			// Handle income generation (same logic as before)
-			if hotspot.LastIncomeTime == nil {
-				if err := s.initializeHotspotIncomeTime(&hotspot); err != nil {
-					s.logger.Error().Err(err).
-						Str("hotspotID", hotspot.ID).
-						Msg("Failed to initialize hotspot income timing")
-				}
-				continue
-			}
-
-			// Calculate time since last income generation
-			timeSinceLastIncome := currentTime.Sub(*hotspot.LastIncomeTime)
-
-			// Only add income if at least one hour has passed
-			if timeSinceLastIncome >= time.Hour {
-				// Calculate number of full hours that have passed
-				fullHoursPassed := int(timeSinceLastIncome.Hours())
-
-				// Calculate new income (hourly rate * hours elapsed)
-				newIncome := hotspot.Income * fullHoursPassed
-
-				// Add new income to pending collection
-				hotspot.PendingCollection += newIncome
-
-				// Update last income time precisely
-				newLastIncomeTime := hotspot.LastIncomeTime.Add(time.Duration(fullHoursPassed) * time.Hour)
-				hotspot.LastIncomeTime = &newLastIncomeTime
-
-				// Calculate next income time
-				nextIncomeTime := newLastIncomeTime.Add(time.Hour)
-
-				// Update the hotspot in the database
-				if err := s.territoryRepo.UpdateHotspot(&hotspot); err != nil {
-					s.logger.Error().Err(err).
-						Str("hotspotID", hotspot.ID).
-						Msg("Failed to update hotspot after income generation")
-					continue
-				}
-
-				// Send SSE event with the updated information
-				s.sseService.SendEventToPlayer(playerID, "income_generated", map[string]interface{}{
-					"hotspot": map[string]interface{}{
-						"id":                hotspot.ID,
-						"name":              hotspot.Name,
-						"regionId":          region.ID,
-						"regionName":        region.Name,
-						"newIncome":         newIncome,
-						"pendingCollection": hotspot.PendingCollection,
-						"lastIncomeTime":    newLastIncomeTime,
-						"nextIncomeTime":    nextIncomeTime,
-					},
-					"timestamp": currentTime,
-				})
-
-				// If significant amount accumulated, send notification to player
-				if newIncome > 1000 {
-					message := fmt.Sprintf("$%s is ready for collection at %s in %s.", formatMoney(newIncome), hotspot.Name, region.Name)
-					if err := s.addNotification(playerID, message, util.NotificationTypeCollection); err != nil {
-						s.logger.Error().Err(err).Msg("Failed to add collection notification")
-					}
-				}
-			}
-		}
-	}
-
-	return nil
-}
-
 // TEMP!!!
 func (s *territoryService) GetSSEService() SSEService {
 	return s.sseService
@@ -429,27 +408,73 @@ func (s *territoryService) GetCitiesByDistrictID(districtID string) ([]model.Cit
 
 // GetCityByID retrieves a city by ID
 func (s *territoryService) GetCityByID(id string) (*model.City, error) {
-	return s.territoryRepo.GetCityByID(id)
+	city, err := s.territoryRepo.GetCityByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Attach the neighborhood bonuses active in the city
+	bonuses, err := s.neighborhoodService.GetCityBonuses(city.ID)
+	if err != nil {
+		s.logger.Error().Err(err).Str("cityID", city.ID).Msg("Failed to get neighborhood bonuses for city")
+		return city, nil // Return the city even if we can't get its bonuses
+	}
+	city.Bonuses = bonuses
+
+	return city, nil
 }
 
 // GetAllHotspots retrieves all hotspots
 func (s *territoryService) GetAllHotspots() ([]model.Hotspot, error) {
-	return s.territoryRepo.GetAllHotspots()
+	hotspots, err := s.territoryRepo.GetAllHotspots()
+	if err != nil {
+		return nil, err
+	}
+
+	return accrueHotspotsIncome(hotspots), nil
 }
 
 // GetHotspotsByCity retrieves hotspots by city ID
 func (s *territoryService) GetHotspotsByCity(cityID string) ([]model.Hotspot, error) {
-	return s.territoryRepo.GetHotspotsByCity(cityID)
+	hotspots, err := s.territoryRepo.GetHotspotsByCity(cityID)
+	if err != nil {
+		return nil, err
+	}
+
+	return accrueHotspotsIncome(hotspots), nil
 }
 
 // GetHotspotByID retrieves a hotspot by ID
 func (s *territoryService) GetHotspotByID(id string) (*model.Hotspot, error) {
-	return s.territoryRepo.GetHotspotByID(id)
+	hotspot, err := s.territoryRepo.GetHotspotByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Bring the pending income up to date and attach the current income breakdown for legal businesses
+	if hotspot.IsLegal {
+		accrueIncome(hotspot, time.Now())
+		s.refreshHotspotIncome(hotspot, nil, nil)
+	}
+
+	// Show the assaults the defender already knows about
+	if hotspot.ControllerID != nil {
+		if assaults := s.spottedAssaults(hotspot); len(assaults) > 0 {
+			hotspot.PendingAssaults = assaults
+		}
+	}
+
+	return hotspot, nil
 }
 
 // GetControlledHotspots retrieves hotspots controlled by a player
 func (s *territoryService) GetControlledHotspots(playerID string) ([]model.Hotspot, error) {
-	return s.territoryRepo.GetControlledHotspots(playerID)
+	hotspots, err := s.territoryRepo.GetControlledHotspots(playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return accrueHotspotsIncome(hotspots), nil
 }
 
 // GetRecentActions retrieves recent territory actions
@@ -465,15 +490,22 @@ func (s *territoryService) PerformAction(playerID, actionType string, request mo
 		return nil, errors.New("failed to get player")
 	}
 
-	// Check if player has enough resources
-	if player.Crew < request.Resources.Crew {
-		return nil, errors.New("not enough crew members")
-	}
-	if player.Weapons < request.Resources.Weapons {
-		return nil, errors.New("not enough weapons")
+	// Check the player is in a state to act
+	if err := checkPlayerCanAct(player); err != nil {
+		return nil, err
 	}
-	if player.Vehicles < request.Resources.Vehicles {
-		return nil, errors.New("not enough vehicles")
+
+	// Check if player has enough resources (withdrawals and transfers draw on the garrison instead)
+	if actionType != util.TerritoryActionTypeWithdraw && actionType != util.TerritoryActionTypeTransfer {
+		if player.Crew < request.Resources.Crew {
+			return nil, errors.New("not enough crew members")
+		}
+		if player.Weapons < request.Resources.Weapons {
+			return nil, errors.New("not enough weapons")
+		}
+		if player.Vehicles < request.Resources.Vehicles {
+			return nil, errors.New("not enough vehicles")
+		}
 	}
 
 	// Get the hotspot
@@ -482,6 +514,9 @@ func (s *territoryService) PerformAction(playerID, actionType string, request mo
 		return nil, errors.New("hotspot not found")
 	}
 
+	// Income earned so far is materialized when the action saves the hotspot
+	accrueIncome(hotspot, time.Now())
+
 	// Initialize action and result
 	action := &model.TerritoryAction{
 		Type:      actionType,
@@ -492,6 +527,19 @@ func (s *territoryService) PerformAction(playerID, actionType string, request mo
 		CreatedAt: time.Now(),
 	}
 
+	// Transfers record where the garrison was sent
+	if actionType == util.TerritoryActionTypeTransfer && request.TargetHotspotID != "" {
+		targetID := request.TargetHotspotID
+		action.TargetID = &targetID
+	}
+
+	// Takeovers and assaults on another player's hotspot record who defended it
+	isAttack := actionType == util.TerritoryActionTypeTakeover || actionType == util.TerritoryActionTypeAssault
+	if isAttack && hotspot.ControllerID != nil && *hotspot.ControllerID != playerID {
+		defenderID := *hotspot.ControllerID
+		action.OpponentID = &defenderID
+	}
+
 	// Process the action based on type
 	var result *model.ActionResult
 	switch actionType {
@@ -503,6 +551,12 @@ func (s *territoryService) PerformAction(playerID, actionType string, request mo
 		result, err = s.handleCollection(player, hotspot, request.Resources)
 	case util.TerritoryActionTypeDefend:
 		result, err = s.handleDefend(player, hotspot, request.Resources)
+	case util.TerritoryActionTypeWithdraw:
+		result, err = s.handleWithdraw(player, hotspot, request.Resources)
+	case util.TerritoryActionTypeTransfer:
+		result, err = s.handleTransfer(player, hotspot, request.TargetHotspotID, request.Resources)
+	case util.TerritoryActionTypeAssault:
+		result, err = s.handleAssault(player, hotspot, request.Resources)
 	default:
 		return nil, errors.New("invalid action type")
 	}
@@ -511,6 +565,14 @@ func (s *territoryService) PerformAction(playerID, actionType string, request mo
 		return nil, err
 	}
 
+	// Extend or reset the failure streak the action counts towards
+	if category, tracked := territoryPityCategory(actionType); tracked {
+		recordPityOutcome(s.playerRepo, s.logger, playerID, category, result.Success)
+	}
+
+	// The neighbourhood remembers the attention the action drew
+	s.addLocalHeat(hotspot, result.HeatGenerated)
+
 	// Set the result on the action
 	action.Result = result
 
@@ -529,16 +591,30 @@ func (s *territoryService) handleExtortion(player *model.Player, hotspot *model.
 		return nil, errors.New("cannot extort legal businesses")
 	}
 
+	// Illegal businesses are frozen while the police crack down on the city
+	city, err := s.territoryRepo.GetCityByID(hotspot.CityID)
+	if err != nil {
+		return nil, errors.New("city not found")
+	}
+	if underCrackdown(city, time.Now()) {
+		return nil, fmt.Errorf("police are cracking down on %s until %s", city.Name, city.CrackdownUntil.Format(time.Kitchen))
+	}
+
+	tuning := s.gameConfig.Mechanics.TerritoryActions.Extortion
+
 	// Calculate success chance
-	successChance := s.calculateSuccessChance(resources, 70, hotspot.DefenseStrength)
+	action := &chanceAction{Player: player, Hotspot: hotspot, City: city, ActionType: util.TerritoryActionTypeExtortion}
+	breakdown := s.calculateSuccessChance(action, resources, tuning.BaseChance, hotspot.DefenseStrength)
 
 	// Roll for success
-	success := rand.Float64()*100 < float64(successChance)
+	success := rand.Float64()*100 < float64(breakdown.FinalChance)
 
 	// Initialize result
 	result := &model.ActionResult{
-		Success: success,
-		Message: "",
+		Success:       success,
+		SuccessChance: breakdown.FinalChance,
+		Breakdown:     breakdown,
+		Message:       "",
 	}
 
 	// Deduct player resources used for the action
@@ -550,32 +626,19 @@ func (s *territoryService) handleExtortion(player *model.Player, hotspot *model.
 
 	// Process the result based on success/failure
 	if success {
-		// Calculate money gained (based on business type and resources committed)
-		baseGain := 500 + (rand.Intn(11) * 100) // $500-$1500 base
-		resourceMultiplier := 1.0 + (float64(resources.Crew+resources.Weapons*2+resources.Vehicles*3) / 20.0)
+		// Calculate money gained (based on resources committed)
+		baseGain := rollSteppedRange(tuning.BaseGain, tuning.GainStep)
+		weights := tuning.PayoutWeights
+		committedWeight := float64(resources.Crew)*weights.Crew + float64(resources.Weapons)*weights.Weapons + float64(resources.Vehicles)*weights.Vehicles
+		resourceMultiplier := 1.0 + (committedWeight / tuning.PayoutDivisor)
 		moneyGained := int(float64(baseGain) * resourceMultiplier)
 
-		// Small chance to gain additional resources
-		if rand.Intn(100) < 20 {
-			// Potentially gain crew
-			if rand.Intn(100) < 30 {
-				crewGained := rand.Intn(2) + 1
-				result.CrewGained = crewGained
-				resourceUpdates["crew"] += crewGained
-			}
-
-			// Potentially gain weapons
-			if rand.Intn(100) < 20 {
-				weaponsGained := rand.Intn(2) + 1
-				result.WeaponsGained = weaponsGained
-				resourceUpdates["weapons"] += weaponsGained
-			}
+		// Business owners pay less when police are watching the city
+		moneyGained = moneyGained * (100 - s.localHeatPayoutPenalty(city)) / 100
 
-			// Very small chance to gain a vehicle
-			if rand.Intn(100) < 5 {
-				result.VehiclesGained = 1
-				resourceUpdates["vehicles"] += 1
-			}
+		// Small chance to gain additional resources
+		if rollChance(tuning.BonusDropChance) {
+			rollResourceGains(tuning.BonusDrops, result, resourceUpdates)
 		}
 
 		// Add money gained
@@ -583,12 +646,12 @@ func (s *territoryService) handleExtortion(player *model.Player, hotspot *model.
 		resourceUpdates["money"] = moneyGained
 
 		// Generate heat
-		heatGenerated := 5 + rand.Intn(6) // 5-10 heat
+		heatGenerated := rollRange(tuning.SuccessHeat)
 		result.HeatGenerated = heatGenerated
 		resourceUpdates["heat"] = heatGenerated
 
 		// Generate respect
-		respectGained := 1 + rand.Intn(3) // 1-3 respect
+		respectGained := rollRange(tuning.SuccessRespect)
 		result.RespectGained = respectGained
 		resourceUpdates["respect"] = respectGained
 
@@ -596,36 +659,10 @@ func (s *territoryService) handleExtortion(player *model.Player, hotspot *model.
 		result.Message = fmt.Sprintf("Extortion successful. You collected $%s from %s.", formatMoney(moneyGained), hotspot.Name)
 	} else {
 		// On failure, potential resource loss and higher heat
-
-		// Chance to lose crew
-		if rand.Intn(100) < 30 {
-			crewLost := rand.Intn(resources.Crew) + 1
-			if crewLost > resources.Crew {
-				crewLost = resources.Crew
-			}
-			result.CrewLost = crewLost
-			resourceUpdates["crew"] -= crewLost
-		}
-
-		// Chance to lose weapons
-		if rand.Intn(100) < 20 && resources.Weapons > 0 {
-			weaponsLost := rand.Intn(resources.Weapons) + 1
-			if weaponsLost > resources.Weapons {
-				weaponsLost = resources.Weapons
-			}
-			result.WeaponsLost = weaponsLost
-			resourceUpdates["weapons"] -= weaponsLost
-		}
-
-		// Smaller chance to lose a vehicle
-		if rand.Intn(100) < 10 && resources.Vehicles > 0 {
-			vehiclesLost := 1
-			result.VehiclesLost = vehiclesLost
-			resourceUpdates["vehicles"] -= vehiclesLost
-		}
+		rollResourceLosses(tuning.FailureLosses, resources, result, resourceUpdates)
 
 		// Generate higher heat on failure
-		heatGenerated := 8 + rand.Intn(8) // 8-15 heat
+		heatGenerated := rollRange(tuning.FailureHeat)
 		result.HeatGenerated = heatGenerated
 		resourceUpdates["heat"] = heatGenerated
 
@@ -654,9 +691,12 @@ func (s *territoryService) handleTakeover(player *model.Player, hotspot *model.H
 		return nil, errors.New("cannot take over illegal businesses")
 	}
 
+	tuning := s.gameConfig.Mechanics.TerritoryActions.Takeover
+
 	// Calculate base success chance
 	var baseSuccessChance int
 	var defenseStrength int
+	var bonusMap *NeighborhoodBonusMap
 
 	// If the hotspot is already controlled, takeover is harder
 	if hotspot.ControllerID != nil {
@@ -665,23 +705,46 @@ func (s *territoryService) handleTakeover(player *model.Player, hotspot *model.H
 			return nil, errors.New("you already control this business")
 		}
 
-		baseSuccessChance = 50 // Harder to take from another player
+		// Players recovering from a major loss can't be targeted
+		if isProtected(hotspot.ProtectedUntil, time.Now()) {
+			return nil, fmt.Errorf("%s is under protection until %s", hotspot.Name, hotspot.ProtectedUntil.Format(time.Kitchen))
+		}
+
+		// Attacking another player gives up the attacker's own shield
+		s.endProtection(player)
+
+		baseSuccessChance = tuning.BaseChanceControlled // Harder to take from another player
 		defenseStrength = hotspot.DefenseStrength
+
+		// Neighborhood control makes the defender harder to dislodge
+		var err error
+		if bonusMap, err = s.neighborhoodService.GetBonusMap(); err != nil {
+			s.logger.Error().Err(err).Msg("Failed to get neighborhood bonuses for hotspot")
+		} else {
+			defenseStrength = defenseStrength * (100 + neighborhoodDefenseBonus(bonusMap.HotspotBonuses(hotspot))) / 100
+		}
 	} else {
-		baseSuccessChance = 75 // Easier to take an uncontrolled business
-		defenseStrength = 0    // No defense
+		baseSuccessChance = tuning.BaseChanceUncontrolled // Easier to take an uncontrolled business
+		defenseStrength = 0                               // No defense
 	}
 
+	// Compare the standing of both sides once, it sets both the chance bonus and how rewards scale
+	matchup := s.underdogMatchup(player, hotspot)
+
 	// Calculate final success chance
-	successChance := s.calculateSuccessChance(resources, baseSuccessChance, defenseStrength)
+	action := &chanceAction{Player: player, Hotspot: hotspot, City: s.hotspotCity(hotspot), ActionType: util.TerritoryActionTypeTakeover, Matchup: matchup}
+	breakdown := s.calculateSuccessChance(action, resources, baseSuccessChance, defenseStrength)
 
 	// Roll for success
-	success := rand.Float64()*100 < float64(successChance)
+	success := rand.Float64()*100 < float64(breakdown.FinalChance)
 
 	// Initialize result
 	result := &model.ActionResult{
-		Success: success,
-		Message: "",
+		Success:       success,
+		SuccessChance: breakdown.FinalChance,
+		Breakdown:     breakdown,
+		Underdog:      matchup,
+		Message:       "",
 	}
 
 	// Deduct player resources used for the action
@@ -691,36 +754,70 @@ func (s *territoryService) handleTakeover(player *model.Player, hotspot *model.H
 		"vehicles": -resources.Vehicles,
 	}
 
+	// Get previous controller ID (if any)
+	previousControllerID := hotspot.ControllerID
+
 	// Process the result based on success/failure
 	if success {
-		// Get previous controller ID (if any)
-		previousControllerID := hotspot.ControllerID
+		previousGarrison := model.ActionResources{
+			Crew:     hotspot.Crew,
+			Weapons:  hotspot.Weapons,
+			Vehicles: hotspot.Vehicles,
+		}
+
+		// Split the defeated garrison, the attacker keeps what they captured
+		if previousControllerID != nil {
+			result.Garrison = resolveGarrison(previousGarrison, tuning.GarrisonSplit)
+			result.CrewGained = result.Garrison.Captured.Crew
+			result.WeaponsGained = result.Garrison.Captured.Weapons
+			result.VehiclesGained = result.Garrison.Captured.Vehicles
+			resourceUpdates["crew"] += result.CrewGained
+			resourceUpdates["weapons"] += result.WeaponsGained
+			resourceUpdates["vehicles"] += result.VehiclesGained
+		}
+
+		// Taking the business by force wrecks part of its fortifications
+		s.loseFortificationsOnTakeover(hotspot)
 
 		// Update the hotspot control
 		hotspot.ControllerID = &player.ID
 		hotspot.Crew = resources.Crew
 		hotspot.Weapons = resources.Weapons
 		hotspot.Vehicles = resources.Vehicles
-		hotspot.DefenseStrength = (resources.Crew * 10) + (resources.Weapons * 15) + (resources.Vehicles * 20)
 
-		// If there was a previous controller, they lose the resources allocated
+		// Income now depends on the new controller
+		s.refreshHotspotIncome(hotspot, player, bonusMap)
+
+		// If there was a previous controller, they lose the garrison except what fled
 		if previousControllerID != nil && *previousControllerID != player.ID {
 			// Add notification to previous controller
 			previousControllerMessage := fmt.Sprintf("Your business %s has been taken over by %s!", hotspot.Name, player.Name)
+			if fled := result.Garrison.Fled; fled.Crew+fled.Weapons+fled.Vehicles > 0 {
+				previousControllerMessage += fmt.Sprintf(" %d crew, %d weapons and %d vehicles made it back to you.",
+					fled.Crew, fled.Weapons, fled.Vehicles)
+			}
 			if err := s.addNotification(*previousControllerID, previousControllerMessage, util.NotificationTypeTerritory); err != nil {
 				s.logger.Error().Err(err).Msg("Failed to add notification to previous controller")
 			}
 
-			// Update previous controller's stats
-			// In a complete implementation, we would update the previous controller's stats here
+			// Return what fled and record the defender's side of the fight
+			s.recordTakeoverDefense(player, *previousControllerID, hotspot, previousGarrison, result.Garrison)
 		}
 
 		// Update the hotspot
-		if err := s.territoryRepo.UpdateHotspot(hotspot); err != nil {
+		if err := s.SaveHotspot(hotspot); err != nil {
 			s.logger.Error().Err(err).Msg("Failed to update hotspot after takeover")
 			return nil, errors.New("failed to update hotspot")
 		}
 
+		// Close the previous controller's ownership period and open the attacker's
+		s.recordOwnershipChange(hotspot.ID, &player.ID, util.OwnershipChangeTakeover)
+
+		// A defender who has now lost too much gets a breather
+		if previousControllerID != nil && *previousControllerID != player.ID {
+			s.checkHotspotLosses(*previousControllerID)
+		}
+
 		// Initialize income timing for the newly controlled hotspot
 		if err := s.initializeHotspotIncomeTime(hotspot); err != nil {
 			s.logger.Error().Err(err).
@@ -747,15 +844,15 @@ func (s *territoryService) handleTakeover(player *model.Player, hotspot *model.H
 		})
 
 		// Generate respect and influence
-		respectGained := 3 + rand.Intn(3)   // 3-5 respect
-		influenceGained := 2 + rand.Intn(3) // 2-4 influence
+		respectGained := scaleReward(rollRange(tuning.SuccessRespect), matchup)
+		influenceGained := scaleReward(rollRange(tuning.SuccessInfluence), matchup)
 		result.RespectGained = respectGained
 		result.InfluenceGained = influenceGained
 		resourceUpdates["respect"] = respectGained
 		resourceUpdates["influence"] = influenceGained
 
 		// Generate heat
-		heatGenerated := 3 + rand.Intn(5) // 3-7 heat
+		heatGenerated := rollRange(tuning.SuccessHeat)
 		result.HeatGenerated = heatGenerated
 		resourceUpdates["heat"] = heatGenerated
 
@@ -789,41 +886,15 @@ func (s *territoryService) handleTakeover(player *model.Player, hotspot *model.H
 		}
 	} else {
 		// On failure, lose resources and generate heat
-
-		// Chance to lose additional crew
-		if rand.Intn(100) < 40 {
-			crewLost := rand.Intn(resources.Crew) + 1
-			if crewLost > resources.Crew {
-				crewLost = resources.Crew
-			}
-			result.CrewLost = crewLost
-			resourceUpdates["crew"] -= crewLost
-		}
-
-		// Chance to lose additional weapons
-		if rand.Intn(100) < 30 && resources.Weapons > 0 {
-			weaponsLost := rand.Intn(resources.Weapons) + 1
-			if weaponsLost > resources.Weapons {
-				weaponsLost = resources.Weapons
-			}
-			result.WeaponsLost = weaponsLost
-			resourceUpdates["weapons"] -= weaponsLost
-		}
-
-		// Chance to lose additional vehicles
-		if rand.Intn(100) < 20 && resources.Vehicles > 0 {
-			vehiclesLost := 1
-			result.VehiclesLost = vehiclesLost
-			resourceUpdates["vehicles"] -= vehiclesLost
-		}
+		rollResourceLosses(tuning.FailureLosses, resources, result, resourceUpdates)
 
 		// Generate heat
-		heatGenerated := 5 + rand.Intn(6) // 5-10 heat
+		heatGenerated := rollRange(tuning.FailureHeat)
 		result.HeatGenerated = heatGenerated
 		resourceUpdates["heat"] = heatGenerated
 
 		// Lose respect on failure
-		respectLost := 1 + rand.Intn(2) // 1-2 respect
+		respectLost := rollRange(tuning.FailureRespectLoss)
 		result.RespectLost = respectLost
 		resourceUpdates["respect"] = -respectLost
 
@@ -836,6 +907,10 @@ func (s *territoryService) handleTakeover(player *model.Player, hotspot *model.H
 			if err := s.addNotification(*hotspot.ControllerID, defenderMessage, util.NotificationTypeTerritory); err != nil {
 				s.logger.Error().Err(err).Msg("Failed to add notification to defender")
 			}
+
+			// Record the defender's side of the fight
+			garrison := model.ActionResources{Crew: hotspot.Crew, Weapons: hotspot.Weapons, Vehicles: hotspot.Vehicles}
+			s.recordTakeoverDefense(player, *hotspot.ControllerID, hotspot, garrison, nil)
 		} else {
 			result.Message = fmt.Sprintf("Takeover failed. The police intervened before you could secure %s.", hotspot.Name)
 		}
@@ -846,6 +921,9 @@ func (s *territoryService) handleTakeover(player *model.Player, hotspot *model.H
 			stats.FailedTakeovers++
 			s.playerRepo.UpdatePlayerStats(stats)
 		}
+
+		// Losing most of one's forces in a single attempt counts as a major loss
+		s.checkResourceWipe(player, resourceUpdates)
 	}
 
 	// Update player resources
@@ -854,6 +932,14 @@ func (s *territoryService) handleTakeover(player *model.Player, hotspot *model.H
 		return nil, errors.New("failed to update player resources")
 	}
 
+	// Control changing hands moves both sides' neighborhood bonuses, and the attacker's heat has changed
+	if success {
+		s.refreshIncome(player.ID)
+		if previousControllerID != nil {
+			s.refreshIncome(*previousControllerID)
+		}
+	}
+
 	// Add notification
 	if err := s.addNotification(player.ID, result.Message, util.NotificationTypeTerritory); err != nil {
 		s.logger.Error().Err(err).Msg("Failed to add notification after takeover")
@@ -862,6 +948,77 @@ func (s *territoryService) handleTakeover(player *model.Player, hotspot *model.H
 	return result, nil
 }
 
+// recordTakeoverDefense settles the defender's side of a takeover attempt.
+// A nil resolution means the defender held the hotspot.
+func (s *territoryService) recordTakeoverDefense(attacker *model.Player, defenderID string, hotspot *model.Hotspot, garrison model.ActionResources, resolution *model.GarrisonResolution) {
+	defended := resolution == nil
+	result := &model.ActionResult{Success: defended}
+
+	if defended {
+		result.Message = fmt.Sprintf("You held %s against %s.", hotspot.Name, attacker.Name)
+	} else {
+		result.CrewLost = resolution.Destroyed.Crew + resolution.Captured.Crew
+		result.WeaponsLost = resolution.Destroyed.Weapons + resolution.Captured.Weapons
+		result.VehiclesLost = resolution.Destroyed.Vehicles + resolution.Captured.Vehicles
+		result.Garrison = resolution
+		result.Message = fmt.Sprintf("You lost %s to %s.", hotspot.Name, attacker.Name)
+
+		// Whatever fled makes it back to the defender
+		fledUpdates := map[string]int{
+			"crew":     resolution.Fled.Crew,
+			"weapons":  resolution.Fled.Weapons,
+			"vehicles": resolution.Fled.Vehicles,
+		}
+		if err := s.updatePlayerResources(defenderID, fledUpdates); err != nil {
+			s.logger.Error().Err(err).Msg("Failed to return fled garrison to defender")
+		}
+	}
+
+	// Update defender stats
+	stats, err := s.playerRepo.GetPlayerStats(defenderID)
+	if err == nil {
+		if defended {
+			stats.DefensesWon++
+		} else {
+			stats.DefensesLost++
+		}
+		s.playerRepo.UpdatePlayerStats(stats)
+	}
+
+	// Record the action from the defender's perspective
+	attackerID := attacker.ID
+	action := &model.TerritoryAction{
+		Type:       util.TerritoryActionTypeTakeoverDefense,
+		PlayerID:   defenderID,
+		HotspotID:  hotspot.ID,
+		OpponentID: &attackerID,
+		Resources:  garrison,
+		Result:     result,
+		Timestamp:  time.Now(),
+		CreatedAt:  time.Now(),
+	}
+	if err := s.territoryRepo.AddTerritoryAction(action); err != nil {
+		s.logger.Error().Err(err).Msg("Failed to record takeover defense")
+	}
+}
+
+// resolveGarrison splits a defeated garrison by the configured percentages, with rounding remainders destroyed
+func resolveGarrison(garrison model.ActionResources, split config.GarrisonSplit) *model.GarrisonResolution {
+	divide := func(amount int) (destroyed, captured, fled int) {
+		captured = amount * split.Captured / 100
+		fled = amount * split.Fled / 100
+		destroyed = amount - captured - fled
+		return destroyed, captured, fled
+	}
+
+	resolution := &model.GarrisonResolution{}
+	resolution.Destroyed.Crew, resolution.Captured.Crew, resolution.Fled.Crew = divide(garrison.Crew)
+	resolution.Destroyed.Weapons, resolution.Captured.Weapons, resolution.Fled.Weapons = divide(garrison.Weapons)
+	resolution.Destroyed.Vehicles, resolution.Captured.Vehicles, resolution.Fled.Vehicles = divide(garrison.Vehicles)
+
+	return resolution
+}
+
 // handleCollection processes a collection action
 func (s *territoryService) handleCollection(player *model.Player, hotspot *model.Hotspot, resources model.ActionResources) (*model.ActionResult, error) {
 	// Validate the action
@@ -873,25 +1030,34 @@ func (s *territoryService) handleCollection(player *model.Player, hotspot *model
 		return nil, errors.New("you do not control this business")
 	}
 
+	if err := checkHotspotOpen(hotspot, time.Now()); err != nil {
+		return nil, err
+	}
+
 	if hotspot.PendingCollection <= 0 {
 		return nil, errors.New("no pending collections available")
 	}
 
+	tuning := s.gameConfig.Mechanics.TerritoryActions.Collection
+
 	// Calculate success chance (higher amounts are riskier)
-	baseSuccessChance := 95 - (hotspot.PendingCollection / 1000)
-	if baseSuccessChance < 60 {
-		baseSuccessChance = 60 // Minimum 60% chance
+	baseSuccessChance := tuning.BaseChance - (hotspot.PendingCollection / tuning.PendingPenaltyStep)
+	if baseSuccessChance < tuning.MinBaseChance {
+		baseSuccessChance = tuning.MinBaseChance
 	}
 
-	successChance := s.calculateSuccessChance(resources, baseSuccessChance, 0)
+	action := &chanceAction{Player: player, Hotspot: hotspot, City: s.hotspotCity(hotspot), ActionType: util.TerritoryActionTypeCollection}
+	breakdown := s.calculateSuccessChance(action, resources, baseSuccessChance, 0)
 
 	// Roll for success
-	success := rand.Float64()*100 < float64(successChance)
+	success := rand.Float64()*100 < float64(breakdown.FinalChance)
 
 	// Initialize result
 	result := &model.ActionResult{
-		Success: success,
-		Message: "",
+		Success:       success,
+		SuccessChance: breakdown.FinalChance,
+		Breakdown:     breakdown,
+		Message:       "",
 	}
 
 	// Deduct player resources used for the action
@@ -914,7 +1080,7 @@ func (s *territoryService) handleCollection(player *model.Player, hotspot *model
 		}()
 
 		// Update the hotspot
-		if err := s.territoryRepo.UpdateHotspot(hotspot); err != nil {
+		if err := s.SaveHotspot(hotspot); err != nil {
 			s.logger.Error().Err(err).Msg("Failed to update hotspot after collection")
 			return nil, errors.New("failed to update hotspot")
 		}
@@ -924,7 +1090,7 @@ func (s *territoryService) handleCollection(player *model.Player, hotspot *model
 		resourceUpdates["money"] = moneyGained
 
 		// Small amount of heat generation
-		heatGenerated := 1 + rand.Intn(3) // 1-3 heat
+		heatGenerated := rollRange(tuning.SuccessHeat)
 		result.HeatGenerated = heatGenerated
 		resourceUpdates["heat"] = heatGenerated
 
@@ -934,32 +1100,25 @@ func (s *territoryService) handleCollection(player *model.Player, hotspot *model
 		// On failure, lose money and generate heat
 
 		// Calculate money lost (portion of pending collection)
-		percentLost := 30 + rand.Intn(41) // 30-70%
+		percentLost := rollRange(tuning.FailureLossPercent)
 		moneyLost := (hotspot.PendingCollection * percentLost) / 100
 
 		// Reduce pending collection
 		hotspot.PendingCollection -= moneyLost
 
 		// Update the hotspot
-		if err := s.territoryRepo.UpdateHotspot(hotspot); err != nil {
+		if err := s.SaveHotspot(hotspot); err != nil {
 			s.logger.Error().Err(err).Msg("Failed to update hotspot after failed collection")
 			return nil, errors.New("failed to update hotspot")
 		}
 
 		result.MoneyLost = moneyLost
 
-		// Chance to lose crew
-		if rand.Intn(100) < 30 && resources.Crew > 0 {
-			crewLost := rand.Intn(resources.Crew) + 1
-			if crewLost > resources.Crew {
-				crewLost = resources.Crew
-			}
-			result.CrewLost = crewLost
-			resourceUpdates["crew"] -= crewLost
-		}
+		// Chance to lose committed resources
+		rollResourceLosses(tuning.FailureLosses, resources, result, resourceUpdates)
 
 		// Generate higher heat on failure
-		heatGenerated := 5 + rand.Intn(6) // 5-10 heat
+		heatGenerated := rollRange(tuning.FailureHeat)
 		result.HeatGenerated = heatGenerated
 		resourceUpdates["heat"] = heatGenerated
 
@@ -992,6 +1151,23 @@ func (s *territoryService) handleDefend(player *model.Player, hotspot *model.Hot
 		return nil, errors.New("you do not control this business")
 	}
 
+	tuning := s.gameConfig.Mechanics.TerritoryActions.Defend
+
+	// A business only has room for so much of each resource
+	limits := []struct {
+		resource         string
+		max, held, added int
+	}{
+		{"crew", tuning.MaxGarrison.Crew, hotspot.Crew, resources.Crew},
+		{"weapons", tuning.MaxGarrison.Weapons, hotspot.Weapons, resources.Weapons},
+		{"vehicles", tuning.MaxGarrison.Vehicles, hotspot.Vehicles, resources.Vehicles},
+	}
+	for _, limit := range limits {
+		if limit.max > 0 && limit.held+limit.added > limit.max {
+			return nil, fmt.Errorf("%s can hold at most %d %s (it has %d)", hotspot.Name, limit.max, limit.resource, limit.held)
+		}
+	}
+
 	// Defense is always successful
 	result := &model.ActionResult{
 		Success: true,
@@ -1005,16 +1181,19 @@ func (s *territoryService) handleDefend(player *model.Player, hotspot *model.Hot
 		"vehicles": -resources.Vehicles,
 	}
 
+	// Reinforcing may draw some attention
+	if heatGenerated := rollRange(tuning.SuccessHeat); heatGenerated > 0 {
+		result.HeatGenerated = heatGenerated
+		resourceUpdates["heat"] = heatGenerated
+	}
+
 	// Add resources to hotspot defense
 	hotspot.Crew += resources.Crew
 	hotspot.Weapons += resources.Weapons
 	hotspot.Vehicles += resources.Vehicles
 
-	// Calculate new defense strength
-	hotspot.DefenseStrength = (hotspot.Crew * 10) + (hotspot.Weapons * 15) + (hotspot.Vehicles * 20)
-
-	// Update the hotspot
-	if err := s.territoryRepo.UpdateHotspot(hotspot); err != nil {
+	// Update the hotspot, which recalculates its defense strength
+	if err := s.SaveHotspot(hotspot); err != nil {
 		s.logger.Error().Err(err).Msg("Failed to update hotspot defense")
 		return nil, errors.New("failed to update hotspot")
 	}
@@ -1038,6 +1217,16 @@ func (s *territoryService) handleDefend(player *model.Player, hotspot *model.Hot
 
 // CollectHotspotIncome collects pending income from a specific hotspot
 func (s *territoryService) CollectHotspotIncome(playerID, hotspotID string) (*model.CollectResponse, error) {
+	player, err := s.playerRepo.GetPlayerByID(playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the player is in a state to act
+	if err := checkPlayerCanAct(player); err != nil {
+		return nil, err
+	}
+
 	// Verify player owns the hotspot
 	hotspot, err := s.territoryRepo.GetHotspotByID(hotspotID)
 	if err != nil {
@@ -1048,6 +1237,13 @@ func (s *territoryService) CollectHotspotIncome(playerID, hotspotID string) (*mo
 		return nil, errors.New("you do not control this hotspot")
 	}
 
+	if err := checkHotspotOpen(hotspot, time.Now()); err != nil {
+		return nil, err
+	}
+
+	// Materialize the income earned since the last payout
+	accrueIncome(hotspot, time.Now())
+
 	if hotspot.PendingCollection <= 0 {
 		return nil, errors.New("no resources available to collect")
 	}
@@ -1063,7 +1259,7 @@ func (s *territoryService) CollectHotspotIncome(playerID, hotspotID string) (*mo
 	}()
 
 	// Update the hotspot
-	if err := s.territoryRepo.UpdateHotspot(hotspot); err != nil {
+	if err := s.SaveHotspot(hotspot); err != nil {
 		s.logger.Error().Err(err).
 			Str("hotspotID", hotspotID).
 			Msg("Failed to update hotspot after collection")
@@ -1105,6 +1301,16 @@ func (s *territoryService) CollectHotspotIncome(playerID, hotspotID string) (*mo
 
 // CollectAllHotspotIncome collects pending income from all hotspots controlled by a player
 func (s *territoryService) CollectAllHotspotIncome(playerID string) (*model.CollectAllResponse, error) {
+	player, err := s.playerRepo.GetPlayerByID(playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the player is in a state to act
+	if err := checkPlayerCanAct(player); err != nil {
+		return nil, err
+	}
+
 	// Get all controlled hotspots
 	hotspots, err := s.territoryRepo.GetControlledHotspots(playerID)
 	if err != nil {
@@ -1114,8 +1320,13 @@ func (s *territoryService) CollectAllHotspotIncome(playerID string) (*model.Coll
 	totalCollected := 0
 	collectedHotspots := 0
 
-	// Collect from each hotspot
-	for _, hotspot := range hotspots {
+	// Collect from each hotspot, materializing the income earned since the last payout
+	for _, hotspot := range accrueHotspotsIncome(hotspots) {
+		// Businesses the police have shut down keep their income until they reopen
+		if checkHotspotOpen(&hotspot, time.Now()) != nil {
+			continue
+		}
+
 		if hotspot.PendingCollection > 0 {
 			// Reset pending collection
 			collectedAmount := hotspot.PendingCollection
@@ -1129,7 +1340,7 @@ func (s *territoryService) CollectAllHotspotIncome(playerID string) (*model.Coll
 			}()
 
 			// Update the hotspot
-			if err := s.territoryRepo.UpdateHotspot(&hotspot); err != nil {
+			if err := s.SaveHotspot(&hotspot); err != nil {
 				s.logger.Error().Err(err).
 					Str("hotspotID", hotspot.ID).
 					Msg("Failed to update hotspot after collection")
@@ -1233,7 +1444,7 @@ func (s *territoryService) UpdateHotspotIncome() error {
 			nextIncomeTime := newLastIncomeTime.Add(time.Hour)
 
 			// Update the hotspot in the database
-			if err := s.territoryRepo.UpdateHotspot(&hotspot); err != nil {
+			if err := s.SaveHotspot(&hotspot); err != nil {
 				s.logger.Error().Err(err).
 					Str("hotspotID", hotspot.ID).
 					Msg("Failed to update hotspot after income generation")
@@ -1267,6 +1478,84 @@ func (s *territoryService) UpdateHotspotIncome() error {
 }
 */
 
+// RotateIllegalBusinesses retires every city's illegal businesses and spawns new ones from the preset pool
+func (s *territoryService) RotateIllegalBusinesses() (*model.IllegalRotationResult, error) {
+	territoryData, err := loadTerritoryFromYAML(s.gameConfig.ConfigDir)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("Failed to load illegal business pool")
+		return nil, err
+	}
+
+	if len(territoryData.IllegalBusinessPool) == 0 {
+		return nil, errors.New("illegal business pool is empty")
+	}
+
+	cities, err := s.territoryRepo.GetAllCities()
+	if err != nil {
+		s.logger.Error().Err(err).Msg("Failed to get cities for illegal business rotation")
+		return nil, err
+	}
+
+	density := territoryData.IllegalBusinessRotation
+	now := time.Now()
+	result := &model.IllegalRotationResult{RotatedAt: now}
+
+	for _, city := range cities {
+		// Find the presets allowed in this city
+		var eligible []IllegalBusinessPresetData
+		for _, preset := range territoryData.IllegalBusinessPool {
+			if preset.allowsCity(city.ID) {
+				eligible = append(eligible, preset)
+			}
+		}
+
+		// Leave cities without any eligible presets untouched
+		if len(eligible) == 0 {
+			continue
+		}
+
+		// Pick distinct presets up to the rolled density
+		count := rollRange(config.IntRange{Min: density.MinPerCity, Max: density.MaxPerCity})
+		if count > len(eligible) {
+			count = len(eligible)
+		}
+
+		spawned := make([]model.Hotspot, 0, count)
+		for _, index := range rand.Perm(len(eligible))[:count] {
+			preset := eligible[index]
+			spawned = append(spawned, model.Hotspot{
+				Name:               preset.Name,
+				CityID:             city.ID,
+				Type:               preset.Type,
+				BusinessType:       preset.BusinessType,
+				IsLegal:            false,
+				Income:             rollRange(preset.Income),
+				LastCollectionTime: &now,
+			})
+		}
+
+		retired, err := s.territoryRepo.RotateIllegalHotspots(city.ID, spawned)
+		if err != nil {
+			s.logger.Error().Err(err).Str("cityId", city.ID).Msg("Failed to rotate illegal businesses")
+			return nil, err
+		}
+
+		result.Retired += retired
+		result.Spawned += len(spawned)
+		result.Cities++
+	}
+
+	// Let connected players know the map changed
+	s.sseService.SendEventToAll("territory_refreshed", map[string]interface{}{
+		"reason":    "illegal_rotation",
+		"retired":   result.Retired,
+		"spawned":   result.Spawned,
+		"timestamp": now,
+	})
+
+	return result, nil
+}
+
 // initializeHotspotIncomeTime sets up initial income timing for a newly controlled hotspot
 func (s *territoryService) initializeHotspotIncomeTime(hotspot *model.Hotspot) error {
 	if hotspot.ControllerID == nil {
@@ -1288,7 +1577,7 @@ func (s *territoryService) initializeHotspotIncomeTime(hotspot *model.Hotspot) e
 		Msg("Initializing hotspot income timing")
 
 	// Update the hotspot in the database
-	if err := s.territoryRepo.UpdateHotspot(hotspot); err != nil {
+	if err := s.SaveHotspot(hotspot); err != nil {
 		return err
 	}
 
@@ -1314,56 +1603,142 @@ func (s *territoryService) initializeHotspotIncomeTime(hotspot *model.Hotspot) e
 	return nil
 }
 
-// calculateSuccessChance calculates the success chance for an action
-func (s *territoryService) calculateSuccessChance(resources model.ActionResources, baseChance, opponentStrength int) int {
+// calculateSuccessChance calculates the success chance for an action, itemizing every modifier applied.
+// The action carries what the caller already looked up, so modifiers don't repeat those lookups.
+func (s *territoryService) calculateSuccessChance(action *chanceAction, resources model.ActionResources, baseChance, opponentStrength int) *model.SuccessBreakdown {
+	breakdown := newSuccessBreakdown(baseChance)
+
+	// Adjust for resources committed
+	breakdown.Add(s.strengthModifier(resources, opponentStrength))
+
+	// Apply every registered modifier
+	for _, modifier := range s.chanceModifiers {
+		breakdown.Add(modifier(action))
+	}
+
+	breakdown.Resolve()
+	return breakdown
+}
+
+// hotspotCity looks up the city a hotspot is in for the chance modifiers, or nil if it cannot be found
+func (s *territoryService) hotspotCity(hotspot *model.Hotspot) *model.City {
+	city, err := s.territoryRepo.GetCityByID(hotspot.CityID)
+	if err != nil {
+		s.logger.Error().Err(err).Str("cityID", hotspot.CityID).Msg("Failed to get city for success chance")
+		return nil
+	}
+	return city
+}
+
+// strengthModifier compares the committed resources against the opposition
+func (s *territoryService) strengthModifier(resources model.ActionResources, opponentStrength int) *model.SuccessModifier {
 	// Calculate player strength
-	playerStrength := (resources.Crew * 10) + (resources.Weapons * 15) + (resources.Vehicles * 20)
+	playerStrength := s.calculateStrength(resources.Crew, resources.Weapons, resources.Vehicles)
+	if playerStrength <= 0 {
+		return nil
+	}
 
-	// Base success rate
-	successChance := baseChance
+	tuning := s.gameConfig.Mechanics.TerritoryActions
 
-	// Adjust for resources committed
-	if playerStrength > 0 {
-		if opponentStrength > 0 {
-			// For actions against opponents, compare strengths
-			strengthRatio := float64(playerStrength) / float64(opponentStrength)
-			if strengthRatio >= 2.0 {
-				successChance += 20 // Major advantage
-			} else if strengthRatio >= 1.5 {
-				successChance += 15 // Significant advantage
-			} else if strengthRatio >= 1.0 {
-				successChance += 10 // Slight advantage
-			} else if strengthRatio >= 0.75 {
-				successChance += 5 // Nearly even
-			} else if strengthRatio >= 0.5 {
-				successChance -= 5 // Disadvantage
-			} else if strengthRatio >= 0.25 {
-				successChance -= 10 // Major disadvantage
-			} else {
-				successChance -= 20 // Severe disadvantage
-			}
-		} else {
-			// For actions without opposition, just add based on strength
-			if playerStrength >= 100 {
-				successChance += 20
-			} else if playerStrength >= 75 {
-				successChance += 15
-			} else if playerStrength >= 50 {
-				successChance += 10
-			} else if playerStrength >= 25 {
-				successChance += 5
+	// For actions against opponents compare strengths, otherwise use the raw strength
+	tiers, measure := tuning.UnopposedStrengthTiers, float64(playerStrength)
+	if opponentStrength > 0 {
+		tiers, measure = tuning.OpposedStrengthTiers, float64(playerStrength)/float64(opponentStrength)
+	}
+
+	// Tiers are ordered from the highest threshold down
+	for _, tier := range tiers {
+		if measure >= tier.Threshold {
+			return &model.SuccessModifier{
+				Source: util.SuccessModifierStrength,
+				Label:  tier.Label,
+				Value:  tier.Bonus,
 			}
 		}
 	}
 
-	// Cap success chance between 5% and 95%
-	if successChance < 5 {
-		successChance = 5
-	} else if successChance > 95 {
-		successChance = 95
+	return nil
+}
+
+// calculateStrength weighs resources using the configured defense values
+func (s *territoryService) calculateStrength(crew, weapons, vehicles int) int {
+	values := s.gameConfig.Mechanics.DefenseValues
+	return (crew * values.Crew) + (weapons * values.Weapons) + (vehicles * values.Vehicles)
+}
+
+// calculateDefenseStrength calculates a hotspot's defense from its garrison and fortifications
+func (s *territoryService) calculateDefenseStrength(hotspot *model.Hotspot) int {
+	return s.fortifiedDefense(hotspot, s.calculateStrength(hotspot.Crew, hotspot.Weapons, hotspot.Vehicles))
+}
+
+// SaveHotspot recalculates a hotspot's defense strength from its garrison and fortifications and saves it.
+// Every change to a hotspot goes through here so its defense never goes stale.
+func (s *territoryService) SaveHotspot(hotspot *model.Hotspot) error {
+	hotspot.DefenseStrength = s.calculateDefenseStrength(hotspot)
+	return s.territoryRepo.UpdateHotspot(hotspot)
+}
+
+// RefreshDefenseStrength recalculates a hotspot's defense after its garrison was changed elsewhere
+func (s *territoryService) RefreshDefenseStrength(hotspotID string) error {
+	hotspot, err := s.territoryRepo.GetHotspotByID(hotspotID)
+	if err != nil {
+		return err
+	}
+	return s.territoryRepo.UpdateHotspotDefenseStrength(hotspotID, s.calculateDefenseStrength(hotspot))
+}
+
+// rollResourceGains applies each configured chance to gain resources
+func rollResourceGains(rolls config.ResourceRolls, result *model.ActionResult, resourceUpdates map[string]int) {
+	if rollChance(rolls.Crew.Chance) {
+		result.CrewGained = rollRange(rolls.Crew.Amount)
+		resourceUpdates["crew"] += result.CrewGained
+	}
+	if rollChance(rolls.Weapons.Chance) {
+		result.WeaponsGained = rollRange(rolls.Weapons.Amount)
+		resourceUpdates["weapons"] += result.WeaponsGained
 	}
+	if rollChance(rolls.Vehicles.Chance) {
+		result.VehiclesGained = rollRange(rolls.Vehicles.Amount)
+		resourceUpdates["vehicles"] += result.VehiclesGained
+	}
+}
 
-	return successChance
+// rollResourceLosses applies each configured chance to lose committed resources
+func rollResourceLosses(rolls config.ResourceRolls, resources model.ActionResources, result *model.ActionResult, resourceUpdates map[string]int) {
+	if lost := rollLoss(rolls.Crew, resources.Crew); lost > 0 {
+		result.CrewLost = lost
+		resourceUpdates["crew"] -= lost
+	}
+	if lost := rollLoss(rolls.Weapons, resources.Weapons); lost > 0 {
+		result.WeaponsLost = lost
+		resourceUpdates["weapons"] -= lost
+	}
+	if lost := rollLoss(rolls.Vehicles, resources.Vehicles); lost > 0 {
+		result.VehiclesLost = lost
+		resourceUpdates["vehicles"] -= lost
+	}
+}
+
+// rollLoss rolls a loss of committed resources, never more than was committed
+func rollLoss(roll config.ResourceRoll, committed int) int {
+	if committed <= 0 || !rollChance(roll.Chance) {
+		return 0
+	}
+
+	amount := roll.Amount
+	if amount.Max == 0 || amount.Max > committed {
+		amount.Max = committed
+	}
+	if amount.Min > amount.Max {
+		amount.Min = amount.Max
+	}
+
+	return rollRange(amount)
+}
+
+// heatChanceModifier applies the police response penalty for the player's heat
+func (s *territoryService) heatChanceModifier(action *chanceAction) *model.SuccessModifier {
+	return heatPenaltyModifier(s.gameConfig.Mechanics, util.HeatEffectTerritoryActionPenalty, action.Player.Heat)
 }
 
 // updatePlayerResources updates multiple resources for a player
@@ -1437,6 +1812,11 @@ func (s *territoryService) updatePlayerTitle(playerID string) error {
 		if err := s.addNotification(playerID, message, util.NotificationTypeSystem); err != nil {
 			s.logger.Error().Err(err).Msg("Failed to add title change notification")
 		}
+
+		// The new title changes what the player's businesses earn
+		if err := s.RefreshPlayerIncome(playerID); err != nil {
+			s.logger.Error().Err(err).Msg("Failed to refresh income after title change")
+		}
 	}
 
 	return nil
@@ -3,6 +3,7 @@ package service
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/rs/zerolog"
@@ -17,7 +18,10 @@ import (
 
 // TerritoryData represents the structure of the territory.yaml file
 type TerritoryData struct {
-	Regions []RegionData `yaml:"regions"`
+	Regions                 []RegionData                `yaml:"regions"`
+	IllegalBusinessRotation IllegalBusinessRotationData `yaml:"illegal_business_rotation"`
+	IllegalBusinessPool     []IllegalBusinessPresetData `yaml:"illegal_business_pool"`
+	RegionRoutes            []RegionRouteData           `yaml:"region_routes"`
 }
 
 // RegionData represents a region in the territory structure
@@ -27,6 +31,13 @@ type RegionData struct {
 	Districts []DistrictData `yaml:"districts"`
 }
 
+// RegionRouteData is a road between two neighboring regions, usable in both directions
+type RegionRouteData struct {
+	From     string `yaml:"from"`
+	To       string `yaml:"to"`
+	Distance int    `yaml:"distance"`
+}
+
 // DistrictData represents a district in the territory structure
 type DistrictData struct {
 	ID     string     `yaml:"id"`
@@ -51,6 +62,34 @@ type HotspotData struct {
 	Income       int    `yaml:"income"`
 }
 
+// IllegalBusinessRotationData controls how many illegal businesses each city gets per rotation
+type IllegalBusinessRotationData struct {
+	MinPerCity int `yaml:"min_per_city"`
+	MaxPerCity int `yaml:"max_per_city"`
+}
+
+// IllegalBusinessPresetData represents an illegal business the rotation can spawn
+type IllegalBusinessPresetData struct {
+	Name         string          `yaml:"name"`
+	Type         string          `yaml:"type"`
+	BusinessType string          `yaml:"business_type"`
+	Income       config.IntRange `yaml:"income"`
+	Cities       []string        `yaml:"cities,omitempty"` // Empty means every city
+}
+
+// allowsCity reports whether the preset can spawn in a city
+func (p IllegalBusinessPresetData) allowsCity(cityID string) bool {
+	if len(p.Cities) == 0 {
+		return true
+	}
+	for _, id := range p.Cities {
+		if id == cityID {
+			return true
+		}
+	}
+	return false
+}
+
 func RunTerritorySeeder(configPath, territoryPath string) {
 	// Initialize logger
 	l := logger.NewLogger()
@@ -101,6 +140,18 @@ func loadTerritoryData(path string) (*TerritoryData, error) {
 	return &territoryData, nil
 }
 
+// loadTerritoryFromYAML reads territory data from the configured YAML file,
+// which sits next to the app config unless the environment points elsewhere
+func loadTerritoryFromYAML(configDir string) (*TerritoryData, error) {
+	// Get the territory YAML file path from environment or use default
+	territoryFile := os.Getenv("TERRITORY_FILE")
+	if territoryFile == "" {
+		territoryFile = filepath.Join(configDir, "territory.yaml")
+	}
+
+	return loadTerritoryData(territoryFile)
+}
+
 // clearExistingTerritoryData clears existing territory data from the database
 func clearExistingTerritoryData(db *gorm.DB, l zerolog.Logger) error {
 	l.Info().Msg("Clearing existing territory data...")
@@ -114,6 +165,10 @@ func clearExistingTerritoryData(db *gorm.DB, l zerolog.Logger) error {
 		return err
 	}
 
+	if err := db.Exec("DELETE FROM region_routes").Error; err != nil {
+		return err
+	}
+
 	if err := db.Exec("DELETE FROM cities").Error; err != nil {
 		return err
 	}
@@ -2,21 +2,122 @@ package service
 
 import "time"
 
-// StartPeriodicIncomeGeneration starts a goroutine that periodically generates income for all hotspots
-func (s *territoryService) StartPeriodicIncomeGeneration() {
-	ticker := time.NewTicker(time.Second) // Check every second
+// StartPeriodicIncomeNotifications starts a goroutine that tells connected players when their hotspots earn income.
+// Income itself accrues lazily whenever a hotspot is read or collected.
+func (s *territoryService) StartPeriodicIncomeNotifications() {
+	ticker := time.NewTicker(30 * time.Second)
 
 	go func() {
-		for {
-			select {
-			case <-ticker.C:
-				// s.logger.Info().Msg("Running periodic income generation for hotspots")
-				if err := s.UpdateHotspotIncome(); err != nil {
-					s.logger.Error().Err(err).Msg("Failed to update hotspot income")
-				}
+		since := time.Now()
+		for now := range ticker.C {
+			if err := s.NotifyAccruedIncome(since, now); err != nil {
+				s.logger.Error().Err(err).Msg("Failed to notify accrued income")
 			}
+			since = now
 		}
 	}()
 
-	s.logger.Info().Msg("Started periodic income generation scheduler")
+	s.logger.Info().Msg("Started income notification scheduler")
+}
+
+// StartPeriodicIllegalBusinessRotation starts a goroutine that periodically rotates the illegal businesses
+func (s *territoryService) StartPeriodicIllegalBusinessRotation() {
+	rotationInterval := time.Duration(s.gameConfig.IllegalRotationInterval) * time.Minute
+	if rotationInterval <= 0 {
+		rotationInterval = 24 * time.Hour // Rotate daily by default
+	}
+
+	go func() {
+		// Pick up the clock from the last rotation so restarts don't reshuffle the map
+		last, err := s.territoryRepo.GetLastIllegalRotation()
+		if err != nil {
+			s.logger.Error().Err(err).Msg("Failed to get last illegal business rotation")
+		} else if last != nil {
+			time.Sleep(time.Until(last.Add(rotationInterval)))
+		}
+
+		s.rotateIllegalBusinessesOnSchedule()
+
+		ticker := time.NewTicker(rotationInterval)
+		for range ticker.C {
+			s.rotateIllegalBusinessesOnSchedule()
+		}
+	}()
+
+	s.logger.Info().Dur("interval", rotationInterval).Msg("Started illegal business rotation scheduler")
+}
+
+// rotateIllegalBusinessesOnSchedule runs one scheduled rotation and logs how it went
+func (s *territoryService) rotateIllegalBusinessesOnSchedule() {
+	result, err := s.RotateIllegalBusinesses()
+	if err != nil {
+		s.logger.Error().Err(err).Msg("Failed to rotate illegal businesses")
+		return
+	}
+
+	s.logger.Info().
+		Int("retired", result.Retired).
+		Int("spawned", result.Spawned).
+		Int("cities", result.Cities).
+		Msg("Illegal businesses rotated")
+}
+
+// StartPeriodicGarrisonTransfers starts a goroutine that periodically completes garrison transfers
+func (s *territoryService) StartPeriodicGarrisonTransfers() {
+	ticker := time.NewTicker(10 * time.Second)
+
+	go func() {
+		for range ticker.C {
+			if err := s.ProcessGarrisonTransfers(); err != nil {
+				s.logger.Error().Err(err).Msg("Failed to process garrison transfers")
+			}
+		}
+	}()
+
+	s.logger.Info().Msg("Started garrison transfer scheduler")
+}
+
+// StartPeriodicFortificationUpgrades starts a goroutine that periodically completes fortification builds
+func (s *territoryService) StartPeriodicFortificationUpgrades() {
+	ticker := time.NewTicker(10 * time.Second)
+
+	go func() {
+		for range ticker.C {
+			if err := s.ProcessFortificationUpgrades(); err != nil {
+				s.logger.Error().Err(err).Msg("Failed to process fortification upgrades")
+			}
+		}
+	}()
+
+	s.logger.Info().Msg("Started fortification upgrade scheduler")
+}
+
+// StartPeriodicAssaultResolution starts a goroutine that periodically warns defenders and resolves staged assaults
+func (s *territoryService) StartPeriodicAssaultResolution() {
+	ticker := time.NewTicker(10 * time.Second)
+
+	go func() {
+		for range ticker.C {
+			if err := s.ProcessAssaults(); err != nil {
+				s.logger.Error().Err(err).Msg("Failed to process assaults")
+			}
+		}
+	}()
+
+	s.logger.Info().Msg("Started assault resolution scheduler")
+}
+
+// StartPeriodicLocalHeatDecay starts a goroutine that periodically cools cities and regions down
+func (s *territoryService) StartPeriodicLocalHeatDecay() {
+	ticker := time.NewTicker(time.Duration(s.gameConfig.Mechanics.LocalHeat.DecayInterval) * time.Second)
+
+	go func() {
+		for range ticker.C {
+			if err := s.ProcessLocalHeatDecay(); err != nil {
+				s.logger.Error().Err(err).Msg("Failed to decay local heat")
+			}
+		}
+	}()
+
+	s.logger.Info().Msg("Started local heat decay scheduler")
 }
@@ -21,14 +21,23 @@ type TravelService interface {
 	// Travel to a specific region
 	Travel(playerID string, regionID string) (*model.TravelResponse, error)
 
-	// Get available regions for travel
-	GetAvailableRegions(playerID string) ([]model.Region, error)
+	// Get available regions for travel, with the route, cost and risk of getting there
+	GetAvailableRegions(playerID string) ([]model.TravelDestination, error)
 
 	// Get travel history for a player
 	GetTravelHistory(playerID string, limit int) ([]model.TravelAttempt, error)
 
 	// Get current region for a player
 	GetCurrentRegion(playerID string) (*model.Region, error)
+
+	// Settle trips whose travel time has passed
+	ProcessArrivals() error
+
+	// Copy the roads between regions from the territory data when none are stored
+	SeedRegionRoutes() error
+
+	// Scheduled jobs
+	StartPeriodicArrivals()
 }
 
 type travelService struct {
@@ -56,13 +65,19 @@ func NewTravelService(
 	}
 }
 
-// Travel handles a player's attempt to travel to a new region
+// Travel sets a player off towards a new region. They arrive once the trip's travel time has passed.
 func (s *travelService) Travel(playerID string, regionID string) (*model.TravelResponse, error) {
-	// Get the player
+	// Get the player, settling any trip that has already ended
 	player, err := s.playerRepo.GetPlayerByID(playerID)
 	if err != nil {
 		return nil, errors.New("player not found")
 	}
+	s.resolveArrivalIfDue(player)
+
+	// Jailed or travelling players can't set off
+	if err := checkPlayerCanAct(player); err != nil {
+		return nil, err
+	}
 
 	// Get the destination region
 	destRegion, err := s.territoryRepo.GetRegionByID(regionID)
@@ -70,61 +85,160 @@ func (s *travelService) Travel(playerID string, regionID string) (*model.TravelR
 		return nil, errors.New("destination region not found")
 	}
 
-	// Get the player's current region if any
-	var fromRegionID *string
-	var fromRegion *model.Region
-	if player.CurrentRegionID != nil {
-		fromRegionID = player.CurrentRegionID
-		fromRegion, _ = s.territoryRepo.GetRegionByID(*player.CurrentRegionID)
-
-		// Check if player is already in the requested region
-		if *player.CurrentRegionID == regionID {
-			return &model.TravelResponse{
-				Success:    true,
-				RegionID:   regionID,
-				RegionName: destRegion.Name,
-				TravelCost: 0,
-				Message:    fmt.Sprintf("You are already in %s.", destRegion.Name),
-			}, nil
-		}
+	// Check if player is already in the requested region
+	if player.CurrentRegionID != nil && *player.CurrentRegionID == regionID {
+		return &model.TravelResponse{
+			Success:    true,
+			RegionID:   regionID,
+			RegionName: destRegion.Name,
+			TravelCost: 0,
+			Message:    fmt.Sprintf("You are already in %s.", destRegion.Name),
+		}, nil
 	}
 
-	// Calculate travel cost - could be based on distance, but we'll use a simple fixed cost for now
-	// In a more advanced implementation, this could be based on a distance matrix between regions
-	baseTravelCost := s.gameConfig.Mechanics.Travel.BaseCost
-	travelCost := baseTravelCost
+	// Plan the route and what it costs
+	regionsByID, _, err := s.regionsByID()
+	if err != nil {
+		return nil, errors.New("failed to get regions")
+	}
+	routes, err := s.regionGraph()
+	if err != nil {
+		return nil, errors.New("failed to get region routes")
+	}
+
+	plan, err := s.planTrip(player, regionID, regionsByID, routes)
+	if err != nil {
+		return nil, err
+	}
+	travelCost := plan.Cost
 
 	// Check if player has enough money
 	if player.Money < travelCost {
 		return nil, errors.New("not enough money to travel")
 	}
 
-	// Calculate chance of getting caught based on player's heat level
-	// Higher heat = higher chance of getting caught
-	baseCatchChance := s.gameConfig.Mechanics.Travel.BaseCatchChance
-	heatMultiplier := s.gameConfig.Mechanics.Travel.HeatMultiplier
+	// Pay for the trip and set off, keeping the route and risk quoted now for the arrival
+	now := time.Now()
+	arrivalTime := now.Add(s.travelTime(player, plan))
+	trip := &model.Trip{
+		PlayerID:     player.ID,
+		FromRegionID: player.CurrentRegionID,
+		ToRegionID:   regionID,
+		ToRegionName: destRegion.Name,
+		Cost:         travelCost,
+		Stops:        plan.Stops,
+		DepartedAt:   now,
+	}
+	player.Money -= travelCost
+	player.InTransitTo = &regionID
+	player.ArrivalTime = &arrivalTime
+
+	if err := s.playerRepo.StartTrip(player, trip); err != nil {
+		return nil, errors.New("failed to update player after travel")
+	}
+
+	response := &model.TravelResponse{
+		Success:     true,
+		RegionID:    regionID,
+		RegionName:  destRegion.Name,
+		TravelCost:  travelCost,
+		Route:       plan.Stops,
+		InTransit:   true,
+		ArrivalTime: &arrivalTime,
+		Message: fmt.Sprintf("You set off for %s for $%d. You will arrive in %s.",
+			destRegion.Name, travelCost, arrivalTime.Sub(now).Round(time.Second)),
+	}
+
+	s.sseService.SendEventToPlayer(playerID, "travel_started", map[string]interface{}{
+		"playerId":    playerID,
+		"regionId":    regionID,
+		"regionName":  destRegion.Name,
+		"travelCost":  travelCost,
+		"route":       plan.Stops,
+		"arrivalTime": arrivalTime.Format(time.RFC3339),
+		"timestamp":   now.Format(time.RFC3339),
+	})
+
+	return response, nil
+}
+
+// ProcessArrivals settles every trip whose travel time has passed
+func (s *travelService) ProcessArrivals() error {
+	players, err := s.playerRepo.GetPlayersArrivingBy(time.Now())
+	if err != nil {
+		return err
+	}
+
+	for i := range players {
+		if _, err := s.resolveArrival(&players[i]); err != nil {
+			s.logger.Error().Err(err).Str("playerID", players[i].ID).Msg("Failed to resolve travel arrival")
+		}
+	}
+
+	return nil
+}
+
+// resolveArrivalIfDue settles the player's trip if it has ended, so reads always see where they really are
+func (s *travelService) resolveArrivalIfDue(player *model.Player) {
+	if player.InTransitTo == nil || player.ArrivalTime == nil || player.ArrivalTime.After(time.Now()) {
+		return
+	}
+
+	if _, err := s.resolveArrival(player); err != nil {
+		s.logger.Error().Err(err).Str("playerID", player.ID).Msg("Failed to resolve travel arrival")
+	}
+}
 
-	catchChance := baseCatchChance + (float64(player.Heat) * heatMultiplier)
+// resolveArrival rolls each police checkpoint the player was quoted when setting off and puts them where the trip ended.
+// A trip whose destination has since been retired is called off and the player stays where they started.
+// It returns nil when another caller settled the trip first.
+func (s *travelService) resolveArrival(player *model.Player) (*model.TravelResponse, error) {
+	if player.InTransitTo == nil {
+		return nil, errors.New("player is not travelling")
+	}
+
+	trip, err := s.playerRepo.GetTrip(player.ID)
+	if err != nil {
+		return nil, errors.New("failed to get trip")
+	}
+	if trip == nil {
+		// Trips started before routes were stored have no checkpoints to roll
+		trip = &model.Trip{PlayerID: player.ID, FromRegionID: player.CurrentRegionID, ToRegionID: *player.InTransitTo, Stops: []model.RouteStop{}}
+	}
+	regionID := trip.ToRegionID
+
+	regionsByID, _, err := s.regionsByID()
+	if err != nil {
+		return nil, errors.New("failed to get regions")
+	}
 
-	// Cap the catch chance at a maximum value (e.g., 75%)
-	maxCatchChance := s.gameConfig.Mechanics.Travel.MaxCatchChance
-	if catchChance > maxCatchChance {
-		catchChance = maxCatchChance
+	destRegion, exists := regionsByID[regionID]
+	if !exists {
+		return s.cancelTrip(player, trip, regionsByID)
 	}
 
-	// Determine if player gets caught
-	caughtByPolice := rand.Float64()*100 < catchChance
+	// Roll every checkpoint in order, the trip ends at the first one that stops the player
+	fromRegionID := player.CurrentRegionID
+	stoppedAt := -1
+	for i, stop := range trip.Stops {
+		if rand.Float64()*100 < stop.CatchChance {
+			stoppedAt = i
+			break
+		}
+	}
+	caughtByPolice := stoppedAt >= 0
 
 	// Initialize travel attempt
+	now := time.Now()
 	travelAttempt := &model.TravelAttempt{
-		PlayerID:       playerID,
+		PlayerID:       player.ID,
 		FromRegionID:   fromRegionID,
 		ToRegionID:     regionID,
 		Success:        !caughtByPolice,
 		CaughtByPolice: caughtByPolice,
-		TravelCost:     travelCost,
-		Timestamp:      time.Now(),
-		CreatedAt:      time.Now(),
+		TravelCost:     trip.Cost,
+		Timestamp:      now,
+		CreatedAt:      now,
 	}
 
 	// Build response
@@ -132,8 +246,9 @@ func (s *travelService) Travel(playerID string, regionID string) (*model.TravelR
 		Success:        !caughtByPolice,
 		RegionID:       regionID,
 		RegionName:     destRegion.Name,
-		TravelCost:     travelCost,
+		TravelCost:     trip.Cost,
 		CaughtByPolice: caughtByPolice,
+		Route:          trip.Stops,
 	}
 
 	// Handle the travel outcome
@@ -163,10 +278,22 @@ func (s *travelService) Travel(playerID string, regionID string) (*model.TravelR
 			fineAmount = player.Money
 		}
 
+		// A stop can end in arrest, more likely the hotter the player is
+		jailedUntil := rollArrest(s.gameConfig.Mechanics.Jail, player, now)
+
 		// Apply the penalties
 		player.Money -= fineAmount
 		player.Heat += heatIncrease
 
+		// The player is turned back to the last region they cleared, if it is still on the map
+		checkpoint := trip.Stops[stoppedAt]
+		if stoppedAt > 0 {
+			clearedRegionID := trip.Stops[stoppedAt-1].RegionID
+			if _, exists := regionsByID[clearedRegionID]; exists {
+				player.CurrentRegionID = &clearedRegionID
+			}
+		}
+
 		// Update the travel attempt
 		travelAttempt.FineAmount = fineAmount
 		travelAttempt.HeatChange = heatIncrease
@@ -174,13 +301,13 @@ func (s *travelService) Travel(playerID string, regionID string) (*model.TravelR
 		// Update the response
 		response.FineAmount = fineAmount
 		response.HeatIncrease = heatIncrease
-		response.Message = fmt.Sprintf("You were caught by the police while trying to travel to %s. You've been fined $%d and your heat has increased by %d.", destRegion.Name, fineAmount, heatIncrease)
-
-		// Player stays in current region
+		response.Message = fmt.Sprintf("You were stopped by the police at the %s checkpoint on the way to %s. You've been fined $%d and your heat has increased by %d.", checkpoint.RegionName, destRegion.Name, fineAmount, heatIncrease)
+		if jailedUntil != nil {
+			response.Arrested = true
+			response.JailedUntil = jailedUntil
+			response.Message += fmt.Sprintf(" You were arrested and will be held until %s.", jailedUntil.Format(time.Kitchen))
+		}
 	} else {
-		// Successful travel - apply costs and benefits
-		player.Money -= travelCost
-
 		// Heat reduction for successful travel
 		heatReduction := s.gameConfig.Mechanics.Travel.SuccessHeatReduction
 
@@ -191,7 +318,6 @@ func (s *travelService) Travel(playerID string, regionID string) (*model.TravelR
 
 		player.Heat -= heatReduction
 		player.CurrentRegionID = &regionID
-		player.LastTravelTime = ptrTime(time.Now())
 
 		// Update the travel attempt
 		travelAttempt.HeatChange = -heatReduction
@@ -201,63 +327,226 @@ func (s *travelService) Travel(playerID string, regionID string) (*model.TravelR
 
 		// Create a nice message
 		fromText := "headquarters"
-		if fromRegion != nil {
-			fromText = fromRegion.Name
+		if fromRegionID != nil {
+			if region, exists := regionsByID[*fromRegionID]; exists {
+				fromText = region.Name
+			}
 		}
 
-		response.Message = fmt.Sprintf("You have successfully traveled from %s to %s for $%d. Your heat has decreased by %d.", fromText, destRegion.Name, travelCost, heatReduction)
+		response.Message = fmt.Sprintf("You have arrived in %s from %s. Your heat has decreased by %d.", destRegion.Name, fromText, heatReduction)
 	}
 
-	// Save the travel attempt
-	err = s.playerRepo.CreateTravelAttempt(travelAttempt)
+	// The trip is over either way
+	player.InTransitTo = nil
+	player.ArrivalTime = nil
+	player.LastTravelTime = ptrTime(now)
+
+	// End the trip, unless another caller already settled it
+	claimed, err := s.playerRepo.CompleteTrip(player.ID, player.CurrentRegionID, travelAttempt.FineAmount, travelAttempt.HeatChange, response.JailedUntil, now)
 	if err != nil {
+		return nil, errors.New("failed to update player after travel")
+	}
+	if !claimed {
+		// Pick up how the other caller left the player
+		if current, err := s.playerRepo.GetPlayerByID(player.ID); err == nil {
+			*player = *current
+		}
+		return nil, nil
+	}
+
+	// Save the travel attempt
+	if err := s.playerRepo.CreateTravelAttempt(travelAttempt); err != nil {
 		s.logger.Error().Err(err).Msg("Failed to save travel attempt")
 	}
 
-	// Update player
-	err = s.playerRepo.UpdatePlayer(player)
-	if err != nil {
-		return nil, errors.New("failed to update player after travel")
+	// Police stops, arrests included, put the checkpoint's region on alert
+	if caughtByPolice {
+		checkpointRegionID := trip.Stops[stoppedAt].RegionID
+		if err := addRegionHeat(s.territoryRepo, s.gameConfig.Mechanics.LocalHeat, []string{checkpointRegionID}, travelAttempt.HeatChange); err != nil {
+			s.logger.Error().Err(err).Str("regionID", checkpointRegionID).Msg("Failed to add local heat for police stop")
+		}
 	}
 
 	// Create notification
 	notification := &model.Notification{
-		PlayerID:  playerID,
+		PlayerID:  player.ID,
 		Message:   response.Message,
 		Type:      util.NotificationTypeTravel,
-		Timestamp: time.Now(),
+		Timestamp: now,
 		Read:      false,
 	}
 
 	// Save notification
 	_ = s.playerRepo.AddNotification(notification)
 
-	// Send SSE notification only if travel was successful
-	if response.Success {
-		s.logger.Info().
-			Str("playerID", playerID).
-			Str("regionID", regionID).
-			Msg("Sending travel success SSE notification")
+	// Let the player know how the trip ended
+	currentRegionID, currentRegionName := "", "headquarters"
+	if player.CurrentRegionID != nil {
+		currentRegionID = *player.CurrentRegionID
+		currentRegionName = regionsByID[currentRegionID].Name
+	}
 
-		// Send the notification
-		s.sseService.SendEventToPlayer(playerID, "player_region_changed", map[string]interface{}{
+	s.sseService.SendEventToPlayer(player.ID, "travel_arrived", map[string]interface{}{
+		"playerId":       player.ID,
+		"regionId":       currentRegionID,
+		"regionName":     currentRegionName,
+		"destinationId":  regionID,
+		"success":        response.Success,
+		"caughtByPolice": caughtByPolice,
+		"arrested":       response.Arrested,
+		"jailedUntil":    response.JailedUntil,
+		"message":        response.Message,
+		"timestamp":      now.Format(time.RFC3339),
+	})
+
+	// Keep clients that track the current region in sync
+	if player.CurrentRegionID != nil && (fromRegionID == nil || *fromRegionID != currentRegionID) {
+		s.sseService.SendEventToPlayer(player.ID, "player_region_changed", map[string]interface{}{
 			"event":      "player_region_changed",
-			"playerId":   playerID,
-			"regionId":   regionID,
-			"regionName": destRegion.Name,
-			"timestamp":  time.Now().Format(time.RFC3339),
+			"playerId":   player.ID,
+			"regionId":   currentRegionID,
+			"regionName": currentRegionName,
+			"timestamp":  now.Format(time.RFC3339),
 		})
 	}
 
 	return response, nil
 }
 
-// GetAvailableRegions returns the list of regions available for travel
-func (s *travelService) GetAvailableRegions(playerID string) ([]model.Region, error) {
-	// In a simple implementation, all regions are available for travel
-	// In a more complex implementation, regions might be unlocked based on player progress
+// cancelTrip ends a trip whose destination is no longer on the map, refunding it and leaving the player where they started
+func (s *travelService) cancelTrip(player *model.Player, trip *model.Trip, regionsByID map[string]model.Region) (*model.TravelResponse, error) {
+	now := time.Now()
+
+	// Only the origin is known to be reachable, unless it was retired too
+	if player.CurrentRegionID != nil {
+		if _, exists := regionsByID[*player.CurrentRegionID]; !exists {
+			player.CurrentRegionID = nil
+		}
+	}
+
+	// A negative fine hands the travel cost back
+	claimed, err := s.playerRepo.CompleteTrip(player.ID, player.CurrentRegionID, -trip.Cost, 0, nil, now)
+	if err != nil {
+		return nil, errors.New("failed to update player after travel")
+	}
+	if !claimed {
+		if current, err := s.playerRepo.GetPlayerByID(player.ID); err == nil {
+			*player = *current
+		}
+		return nil, nil
+	}
+	player.Money += trip.Cost
+	player.InTransitTo = nil
+	player.ArrivalTime = nil
+	player.LastTravelTime = ptrTime(now)
+
+	destinationName := trip.ToRegionName
+	if destinationName == "" {
+		destinationName = "your destination"
+	}
+
+	response := &model.TravelResponse{
+		Success:    false,
+		RegionID:   trip.ToRegionID,
+		RegionName: destinationName,
+		TravelCost: 0,
+		Message:    fmt.Sprintf("The roads to %s were closed while you were on your way. You turned back and your $%d fare was refunded.", destinationName, trip.Cost),
+	}
+
+	if err := s.playerRepo.AddNotification(&model.Notification{
+		PlayerID:  player.ID,
+		Message:   response.Message,
+		Type:      util.NotificationTypeTravel,
+		Timestamp: now,
+		Read:      false,
+	}); err != nil {
+		s.logger.Error().Err(err).Msg("Failed to add trip cancellation notification")
+	}
+
+	currentRegionID, currentRegionName := "", "headquarters"
+	if player.CurrentRegionID != nil {
+		currentRegionID = *player.CurrentRegionID
+		currentRegionName = regionsByID[currentRegionID].Name
+	}
+
+	s.sseService.SendEventToPlayer(player.ID, "travel_arrived", map[string]interface{}{
+		"playerId":      player.ID,
+		"regionId":      currentRegionID,
+		"regionName":    currentRegionName,
+		"destinationId": trip.ToRegionID,
+		"success":       false,
+		"cancelled":     true,
+		"message":       response.Message,
+		"timestamp":     now.Format(time.RFC3339),
+	})
+
+	return response, nil
+}
+
+// travelTime works out how long a trip takes from its distance, shortened by the vehicles the player owns
+func (s *travelService) travelTime(player *model.Player, plan *travelPlan) time.Duration {
+	tuning := s.gameConfig.Mechanics.Travel
+
+	seconds := float64(plan.Distance * tuning.TimePerDistance)
+
+	speedup := float64(player.Vehicles) * tuning.VehicleSpeedup
+	if speedup > tuning.MaxVehicleSpeedup {
+		speedup = tuning.MaxVehicleSpeedup
+	}
+	seconds *= (100 - speedup) / 100
+
+	if seconds < float64(tuning.MinTravelTime) {
+		seconds = float64(tuning.MinTravelTime)
+	}
+
+	return time.Duration(seconds) * time.Second
+}
+
+// GetAvailableRegions returns every region the player can reach, with the route, cost and risk of getting there
+func (s *travelService) GetAvailableRegions(playerID string) ([]model.TravelDestination, error) {
+	player, err := s.playerRepo.GetPlayerByID(playerID)
+	if err != nil {
+		return nil, errors.New("player not found")
+	}
+	s.resolveArrivalIfDue(player)
+
+	regionsByID, regions, err := s.regionsByID()
+	if err != nil {
+		return nil, err
+	}
+	routes, err := s.regionGraph()
+	if err != nil {
+		return nil, err
+	}
+
+	destinations := make([]model.TravelDestination, 0, len(regions))
+	for _, region := range regions {
+		// The current region is listed but costs nothing
+		if player.CurrentRegionID != nil && *player.CurrentRegionID == region.ID {
+			destinations = append(destinations, model.TravelDestination{
+				Region:  region,
+				Current: true,
+				Route:   []model.RouteStop{},
+			})
+			continue
+		}
+
+		plan, err := s.planTrip(player, region.ID, regionsByID, routes)
+		if err != nil {
+			continue // Unreachable regions are left out
+		}
+
+		destinations = append(destinations, model.TravelDestination{
+			Region:      region,
+			TravelCost:  plan.Cost,
+			CatchChance: plan.CatchChance,
+			Hops:        len(plan.Stops),
+			Distance:    plan.Distance,
+			Route:       plan.Stops,
+		})
+	}
 
-	return s.territoryRepo.GetAllRegions()
+	return destinations, nil
 }
 
 // GetTravelHistory retrieves the travel history for a player
@@ -271,6 +560,7 @@ func (s *travelService) GetCurrentRegion(playerID string) (*model.Region, error)
 	if err != nil {
 		return nil, errors.New("player not found")
 	}
+	s.resolveArrivalIfDue(player)
 
 	if player.CurrentRegionID == nil {
 		return nil, errors.New("player has no current region")
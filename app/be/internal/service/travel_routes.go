@@ -0,0 +1,207 @@
+// internal/service/travel_routes.go
+
+package service
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"mwce-be/internal/model"
+)
+
+// regionGraph holds the road distances between neighboring regions
+type regionGraph map[string]map[string]int
+
+// travelPlan is the cheapest way to a destination and what it costs
+type travelPlan struct {
+	Stops       []model.RouteStop
+	Distance    int
+	Cost        int
+	CatchChance float64
+}
+
+// newRegionGraph builds the graph from the stored routes, each usable in both directions
+func newRegionGraph(routes []model.RegionRoute) regionGraph {
+	graph := make(regionGraph)
+	for _, route := range routes {
+		if route.FromRegionID == "" || route.ToRegionID == "" || route.FromRegionID == route.ToRegionID {
+			continue
+		}
+
+		distance := route.Distance
+		if distance <= 0 {
+			distance = 1
+		}
+
+		for _, pair := range [][2]string{{route.FromRegionID, route.ToRegionID}, {route.ToRegionID, route.FromRegionID}} {
+			if graph[pair[0]] == nil {
+				graph[pair[0]] = make(map[string]int)
+			}
+			graph[pair[0]][pair[1]] = distance
+		}
+	}
+	return graph
+}
+
+// shortestRoute finds the regions passed through on the shortest road between two regions, excluding the start.
+// Each leg holds the distance from the previous region.
+func (g regionGraph) shortestRoute(from, to string) ([]string, []int, bool) {
+	if from == to {
+		return []string{}, []int{}, true
+	}
+
+	distances := map[string]int{from: 0}
+	previous := make(map[string]string)
+	visited := make(map[string]bool)
+
+	// The map is small, so a plain scan for the closest unvisited region is enough
+	for {
+		current, best := "", -1
+		for regionID, distance := range distances {
+			if !visited[regionID] && (best < 0 || distance < best) {
+				current, best = regionID, distance
+			}
+		}
+		if current == "" {
+			return nil, nil, false
+		}
+		if current == to {
+			break
+		}
+		visited[current] = true
+
+		for neighbor, distance := range g[current] {
+			candidate := best + distance
+			if known, exists := distances[neighbor]; !exists || candidate < known {
+				distances[neighbor] = candidate
+				previous[neighbor] = current
+			}
+		}
+	}
+
+	// Walk back from the destination
+	route := []string{}
+	legs := []int{}
+	for regionID := to; regionID != from; regionID = previous[regionID] {
+		route = append([]string{regionID}, route...)
+		legs = append([]int{g[previous[regionID]][regionID]}, legs...)
+	}
+
+	return route, legs, true
+}
+
+// planTrip works out the route, cost and police risk of travelling from one region to another.
+// Players without a region, or maps without routes, travel directly in a single hop.
+func (s *travelService) planTrip(player *model.Player, toRegionID string, regions map[string]model.Region, routes regionGraph) (*travelPlan, error) {
+	tuning := s.gameConfig.Mechanics.Travel
+
+	route, legs := []string{toRegionID}, []int{0}
+	if player.CurrentRegionID != nil && len(routes) > 0 {
+		var found bool
+		route, legs, found = routes.shortestRoute(*player.CurrentRegionID, toRegionID)
+		if !found {
+			return nil, errors.New("no route to destination: no roads connect it to your region yet")
+		}
+	}
+
+	plan := &travelPlan{Stops: make([]model.RouteStop, 0, len(route))}
+	passChance := 1.0
+	for i, regionID := range route {
+		// Each region entered is a police checkpoint, and word spreads the further the player goes.
+		// Regions with a lot of local heat are patrolled harder.
+		catchChance := tuning.BaseCatchChance +
+			float64(player.Heat)*tuning.HeatMultiplier +
+			float64(i)*tuning.CatchChancePerHop +
+			float64(regions[regionID].Heat)*s.gameConfig.Mechanics.LocalHeat.CatchChancePerHeat
+		if catchChance > tuning.MaxCatchChance {
+			catchChance = tuning.MaxCatchChance
+		}
+
+		plan.Stops = append(plan.Stops, model.RouteStop{
+			RegionID:    regionID,
+			RegionName:  regions[regionID].Name,
+			Distance:    legs[i],
+			CatchChance: catchChance,
+		})
+		plan.Distance += legs[i]
+		plan.Cost += tuning.BaseCost + legs[i]*tuning.CostPerDistance
+		passChance *= 1 - catchChance/100
+	}
+
+	// The overall risk is the chance of being stopped at any checkpoint
+	plan.CatchChance = math.Round((1-passChance)*1000) / 10
+
+	return plan, nil
+}
+
+// regionGraph loads the current roads between regions.
+// They are read on every plan so regions added through live-ops content are reachable straight away.
+func (s *travelService) regionGraph() (regionGraph, error) {
+	routes, err := s.territoryRepo.GetRegionRoutes()
+	if err != nil {
+		return nil, err
+	}
+	return newRegionGraph(routes), nil
+}
+
+// SeedRegionRoutes copies the roads from the territory data into a database that has none yet
+func (s *travelService) SeedRegionRoutes() error {
+	stored, err := s.territoryRepo.GetRegionRoutes()
+	if err != nil {
+		return err
+	}
+	if len(stored) > 0 {
+		return nil
+	}
+
+	territoryData, err := loadTerritoryFromYAML(s.gameConfig.ConfigDir)
+	if err != nil {
+		return err
+	}
+
+	regions, _, err := s.regionsByID()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	routes := make([]model.RegionRoute, 0, len(territoryData.RegionRoutes))
+	for _, route := range territoryData.RegionRoutes {
+		// Skip roads to regions that were retired or never seeded
+		if _, exists := regions[route.From]; !exists {
+			continue
+		}
+		if _, exists := regions[route.To]; !exists {
+			continue
+		}
+
+		routes = append(routes, model.RegionRoute{
+			FromRegionID: route.From,
+			ToRegionID:   route.To,
+			Distance:     route.Distance,
+			CreatedAt:    now,
+		})
+	}
+
+	if err := s.territoryRepo.CreateRegionRoutes(routes); err != nil {
+		return err
+	}
+
+	s.logger.Info().Int("routes", len(routes)).Msg("Seeded region routes")
+	return nil
+}
+
+// regionsByID maps every region ID to its region
+func (s *travelService) regionsByID() (map[string]model.Region, []model.Region, error) {
+	regions, err := s.territoryRepo.GetAllRegions()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	byID := make(map[string]model.Region, len(regions))
+	for _, region := range regions {
+		byID[region.ID] = region
+	}
+	return byID, regions, nil
+}
@@ -0,0 +1,22 @@
+// internal/service/travel_scheduler.go
+
+package service
+
+import (
+	"time"
+)
+
+// StartPeriodicArrivals starts a goroutine that settles trips whose travel time has passed
+func (s *travelService) StartPeriodicArrivals() {
+	ticker := time.NewTicker(10 * time.Second)
+
+	go func() {
+		for range ticker.C {
+			if err := s.ProcessArrivals(); err != nil {
+				s.logger.Error().Err(err).Msg("Failed to process travel arrivals")
+			}
+		}
+	}()
+
+	s.logger.Info().Msg("Started travel arrival scheduler")
+}
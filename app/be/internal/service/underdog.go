@@ -0,0 +1,93 @@
+// internal/service/underdog.go
+
+package service
+
+import (
+	"math"
+
+	"mwce-be/internal/model"
+	"mwce-be/internal/util"
+)
+
+// underdogMatchup compares the power of a takeover attacker and the hotspot's controller.
+// It returns nil when the hotspot has no other controller to compare against.
+func (s *territoryService) underdogMatchup(attacker *model.Player, hotspot *model.Hotspot) *model.UnderdogMatchup {
+	if hotspot.ControllerID == nil || *hotspot.ControllerID == attacker.ID {
+		return nil
+	}
+
+	defender, err := s.playerRepo.GetPlayerByID(*hotspot.ControllerID)
+	if err != nil {
+		s.logger.Error().Err(err).Str("hotspotID", hotspot.ID).Msg("Failed to get defender for underdog bonus")
+		return nil
+	}
+
+	matchup := &model.UnderdogMatchup{
+		AttackerPower:    s.playerPower(attacker),
+		DefenderPower:    s.playerPower(defender),
+		Ratio:            1,
+		RewardMultiplier: 1,
+	}
+	if matchup.AttackerPower > 0 {
+		matchup.Ratio = float64(matchup.DefenderPower) / float64(matchup.AttackerPower)
+	} else if matchup.DefenderPower > 0 {
+		matchup.Ratio = math.Inf(1)
+	}
+
+	// Tiers are ordered from the highest threshold down
+	for _, tier := range s.gameConfig.Mechanics.TerritoryActions.Takeover.Underdog.Tiers {
+		if matchup.Ratio >= tier.Threshold {
+			matchup.Label = tier.Label
+			matchup.ChanceBonus = tier.ChanceBonus
+			matchup.RewardMultiplier = tier.RewardMultiplier
+			break
+		}
+	}
+
+	// Keep the ratio readable and JSON-safe
+	if math.IsInf(matchup.Ratio, 1) {
+		matchup.Ratio = float64(matchup.DefenderPower)
+	}
+	matchup.Ratio = math.Round(matchup.Ratio*100) / 100
+
+	return matchup
+}
+
+// playerPower scores a player's standing from respect, influence, controlled hotspots and title
+func (s *territoryService) playerPower(player *model.Player) int {
+	tuning := s.gameConfig.Mechanics.TerritoryActions.Takeover.Underdog
+
+	hotspots, err := s.playerRepo.GetControlledHotspotsCount(player.ID)
+	if err != nil {
+		s.logger.Error().Err(err).Str("playerID", player.ID).Msg("Failed to count hotspots for underdog bonus")
+	}
+
+	power := float64(player.Respect)*tuning.PowerWeights.Respect +
+		float64(player.Influence)*tuning.PowerWeights.Influence +
+		float64(hotspots)*tuning.PowerWeights.Hotspot +
+		float64(titleRanks[player.Title])*tuning.PowerWeights.TitleRank
+
+	return int(math.Round(power))
+}
+
+// underdogChanceModifier rewards attacking a player much stronger than yourself
+func (s *territoryService) underdogChanceModifier(action *chanceAction) *model.SuccessModifier {
+	matchup := action.Matchup
+	if matchup == nil || matchup.ChanceBonus == 0 {
+		return nil
+	}
+
+	return &model.SuccessModifier{
+		Source: util.SuccessModifierUnderdog,
+		Label:  matchup.Label,
+		Value:  matchup.ChanceBonus,
+	}
+}
+
+// scaleReward applies an underdog reward multiplier to a gain
+func scaleReward(amount int, matchup *model.UnderdogMatchup) int {
+	if matchup == nil {
+		return amount
+	}
+	return int(math.Round(float64(amount) * matchup.RewardMultiplier))
+}
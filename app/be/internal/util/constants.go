@@ -26,10 +26,14 @@ const (
 
 // Territory action types
 const (
-	TerritoryActionTypeExtortion  = "extortion"
-	TerritoryActionTypeTakeover   = "takeover"
-	TerritoryActionTypeCollection = "collection"
-	TerritoryActionTypeDefend     = "defend"
+	TerritoryActionTypeExtortion       = "extortion"
+	TerritoryActionTypeTakeover        = "takeover"
+	TerritoryActionTypeCollection      = "collection"
+	TerritoryActionTypeDefend          = "defend"
+	TerritoryActionTypeWithdraw        = "withdraw"
+	TerritoryActionTypeTransfer        = "transfer"
+	TerritoryActionTypeTakeoverDefense = "takeover_defense" // Recorded for the defender of a takeover
+	TerritoryActionTypeAssault         = "assault"          // Launches a staged takeover
 )
 
 // Operation types
@@ -81,3 +85,126 @@ const (
 	GameMessageTypeInfo    = "info"
 	GameMessageTypeWarning = "warning"
 )
+
+// Player roles
+const (
+	PlayerRolePlayer     = "player"
+	PlayerRoleModerator  = "moderator"
+	PlayerRoleGameMaster = "game_master"
+	PlayerRoleAdmin      = "admin"
+)
+
+// Account statuses
+const (
+	AccountStatusActive    = "active"
+	AccountStatusSuspended = "suspended"
+	AccountStatusBanned    = "banned"
+)
+
+// Sanction types
+const (
+	SanctionTypeSuspend = "suspend"
+	SanctionTypeBan     = "ban"
+	SanctionTypeLift    = "lift"
+)
+
+// Content entity types
+const (
+	ContentTypeRegion            = "region"
+	ContentTypeDistrict          = "district"
+	ContentTypeCity              = "city"
+	ContentTypeHotspot           = "hotspot"
+	ContentTypeOperationTemplate = "operation_template"
+	ContentTypeCampaign          = "campaign"
+	ContentTypeChapter           = "chapter"
+)
+
+// Content change actions
+const (
+	ContentActionCreate = "create"
+	ContentActionUpdate = "update"
+	ContentActionRetire = "retire"
+)
+
+// Announcement severities
+const (
+	AnnouncementSeverityInfo     = "info"
+	AnnouncementSeverityWarning  = "warning"
+	AnnouncementSeverityCritical = "critical"
+)
+
+// Announcement audiences
+const (
+	AnnouncementAudienceAll    = "all"
+	AnnouncementAudienceRegion = "region"
+	AnnouncementAudienceTitle  = "title"
+)
+
+// Heat effects from the mechanics config
+const (
+	HeatEffectPoliceResponse          = "police_response"
+	HeatEffectOperationSuccessPenalty = "operation_success_penalty"
+	HeatEffectTerritoryActionPenalty  = "territory_action_penalty"
+	HeatEffectIncomePenalty           = "income_penalty"
+)
+
+// Success modifier sources
+const (
+	SuccessModifierStrength      = "strength"
+	SuccessModifierHeat          = "heat"
+	SuccessModifierFortification = "fortification"
+	SuccessModifierUnderdog      = "underdog"
+	SuccessModifierPity          = "pity"
+	SuccessModifierLocalHeat     = "local_heat"
+)
+
+// Failure streak categories
+const (
+	PityCategoryOperations = "operations"
+	PityCategoryExtortion  = "extortion"
+	PityCategoryTakeover   = "takeover"
+)
+
+// Income breakdown factor sources
+const (
+	IncomeFactorBusiness     = "business"
+	IncomeFactorTitle        = "title"
+	IncomeFactorHeat         = "heat"
+	IncomeFactorNeighborhood = "neighborhood"
+)
+
+// Neighborhood bonus scopes
+const (
+	NeighborhoodScopeCity     = "city"
+	NeighborhoodScopeDistrict = "district"
+	NeighborhoodScopeRegion   = "region"
+)
+
+// Garrison transfer statuses
+const (
+	GarrisonTransferStatusInTransit = "in_transit"
+	GarrisonTransferStatusArrived   = "arrived"
+	GarrisonTransferStatusReturned  = "returned"
+)
+
+// Hotspot ownership changes
+const (
+	OwnershipChangeTakeover      = "takeover"
+	OwnershipChangeAdminTransfer = "admin_transfer"
+	OwnershipChangeAdminRelease  = "admin_release"
+)
+
+// Assault statuses
+const (
+	AssaultStatusPreparing = "preparing"
+	AssaultStatusResolving = "resolving" // Claimed by the resolver
+	AssaultStatusSucceeded = "succeeded"
+	AssaultStatusFailed    = "failed"
+	AssaultStatusCalledOff = "called_off"
+)
+
+// Garrison rebalance strategies
+const (
+	RebalanceStrategyEven   = "even"
+	RebalanceStrategyIncome = "income"
+)
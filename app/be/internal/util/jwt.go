@@ -12,14 +12,16 @@ import (
 // Claims is our custom JWT claims
 type Claims struct {
 	UserID string `json:"user_id"`
+	Role   string `json:"role"`
 	jwt.RegisteredClaims
 }
 
 // GenerateToken creates a new JWT token
-func GenerateToken(userID string, secret string, expiration time.Duration) (string, error) {
-	// Create claims with user ID and expiration time
+func GenerateToken(userID string, role string, secret string, expiration time.Duration) (string, error) {
+	// Create claims with user ID, role and expiration time
 	claims := &Claims{
 		UserID: userID,
+		Role:   role,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(expiration)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
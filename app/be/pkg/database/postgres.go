@@ -13,6 +13,7 @@ import (
 // Database is the interface for database operations
 type Database interface {
 	GetDB() *gorm.DB
+	Transaction(fn func(tx Database) error) error
 	Close() error
 }
 
@@ -54,6 +55,14 @@ func (p *PostgresDB) GetDB() *gorm.DB {
 	return p.db
 }
 
+// Transaction runs fn inside a database transaction.
+// Repositories built on tx take part in it, and it is rolled back if fn returns an error.
+func (p *PostgresDB) Transaction(fn func(tx Database) error) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		return fn(&PostgresDB{db: tx})
+	})
+}
+
 // Close closes the database connection
 func (p *PostgresDB) Close() error {
 	sqlDB, err := p.db.DB()